@@ -2,9 +2,14 @@
 module github.com/rob-myers/npc-cli-vite/packages/cli
 
 go 1.24
+
 require (
 	github.com/mailru/easyjson v0.9.1
 	mvdan.cc/sh/v3 v3.11.0
 )
 
-require github.com/josharian/intern v1.0.0 // indirect
+require (
+	github.com/josharian/intern v1.0.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+	golang.org/x/term v0.29.0 // indirect
+)
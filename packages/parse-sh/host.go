@@ -0,0 +1,266 @@
+//go:build js || wasm
+// +build js wasm
+
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"syscall/js"
+
+	"github.com/rob-myers/npc-cli-vite/packages/cli/processor"
+
+	"mvdan.cc/sh/v3/expand"
+)
+
+// `host` is the JS object providing callbacks e.g. into the session's variables.
+// The caller sets `globalThis.__parseShHost` before invoking an export which uses it.
+func host() js.Value {
+	return js.Global().Get("__parseShHost")
+}
+
+// `hostFunc` returns the named host callback, if provided.
+func hostFunc(name string) (js.Value, bool) {
+	h := host()
+	if h.Type() != js.TypeObject {
+		return js.Undefined(), false
+	}
+	fn := h.Get(name)
+	return fn, fn.Type() == js.TypeFunction
+}
+
+// `hostCall` invokes a host callback, where a returned `{ error: string }` becomes a Go error.
+func hostCall(name string, args ...any) (js.Value, error) {
+	fn, ok := hostFunc(name)
+	if !ok {
+		return js.Undefined(), errors.New(name + ": host callback not provided")
+	}
+	result := fn.Invoke(args...)
+	if result.Type() == js.TypeObject && result.Get("error").Type() == js.TypeString {
+		return js.Undefined(), errors.New(result.Get("error").String())
+	}
+	return result, nil
+}
+
+func hostEnviron() *processor.HostEnviron {
+	env := &processor.HostEnviron{}
+	if _, ok := hostFunc("getVar"); ok {
+		env.Lookup = func(name string) expand.Variable {
+			value, err := hostCall("getVar", name)
+			if err != nil {
+				return expand.Variable{}
+			}
+			return jsToVariable(value)
+		}
+	}
+	if _, ok := hostFunc("setVar"); ok {
+		env.Assign = func(name string, vr expand.Variable) error {
+			_, err := hostCall("setVar", name, variableToJs(vr))
+			return err
+		}
+	}
+	if _, ok := hostFunc("varNames"); ok {
+		env.Names = func() []string {
+			value, err := hostCall("varNames")
+			if err != nil {
+				return nil
+			}
+			return jsToStrings(value)
+		}
+	}
+	return env
+}
+
+func evalHost() processor.EvalHost {
+	evalHost := processor.EvalHost{Env: hostEnviron()}
+	if _, ok := hostFunc("fileTest"); ok {
+		evalHost.FileTest = func(op string, x, y string) (bool, error) {
+			value, err := hostCall("fileTest", op, x, y)
+			return value.Truthy(), err
+		}
+	}
+	if _, ok := hostFunc("optionSet"); ok {
+		evalHost.OptionSet = func(name string) bool {
+			value, err := hostCall("optionSet", name)
+			return err == nil && value.Truthy()
+		}
+	}
+	if _, ok := hostFunc("cmdSubst"); ok {
+		evalHost.CmdSubst = func(text string) (string, error) {
+			value, err := hostCall("cmdSubst", text)
+			if err != nil {
+				return "", err
+			}
+			return value.String(), nil
+		}
+	}
+	if _, ok := hostFunc("readDir"); ok {
+		evalHost.ReadDir = func(path string) ([]fs.DirEntry, error) {
+			value, err := hostCall("readDir", path)
+			if err != nil {
+				return nil, err
+			}
+			entries := make([]fs.DirEntry, value.Length())
+			for i := range entries {
+				entry := value.Index(i)
+				entries[i] = processor.HostDirEntry{
+					EntryName: jsString(entry.Get("Name")),
+					Dir:       entry.Get("IsDir").Truthy(),
+				}
+			}
+			return entries, nil
+		}
+	}
+	return evalHost
+}
+
+// `jsToVariable` converts e.g. `{ Kind: "indexed", List: ["a", "b"] }`.
+// An `undefined` or `null` value is an unset variable.
+func jsToVariable(value js.Value) expand.Variable {
+	if value.Type() != js.TypeObject {
+		if value.Type() == js.TypeString {
+			return expand.Variable{Set: true, Kind: expand.String, Str: value.String()}
+		}
+		return expand.Variable{}
+	}
+	vr := expand.Variable{
+		Set:      true,
+		Kind:     processor.ParseValueKind(jsString(value.Get("Kind"))),
+		Str:      jsString(value.Get("Str")),
+		Local:    value.Get("Local").Truthy(),
+		Exported: value.Get("Exported").Truthy(),
+		ReadOnly: value.Get("ReadOnly").Truthy(),
+	}
+	switch vr.Kind {
+	case expand.Indexed:
+		vr.List = jsToStrings(value.Get("List"))
+	case expand.Associative:
+		vr.Map = map[string]string{}
+		entries := js.Global().Get("Object").Call("entries", value.Get("Map"))
+		for i := 0; i < entries.Length(); i++ {
+			vr.Map[entries.Index(i).Index(0).String()] = jsString(entries.Index(i).Index(1))
+		}
+	case expand.Unknown:
+		vr.Kind = expand.String
+	}
+	return vr
+}
+
+func variableToJs(vr expand.Variable) js.Value {
+	if !vr.IsSet() {
+		return js.Undefined()
+	}
+	value := map[string]any{
+		"Kind":     processor.ValueKindName(vr.Kind),
+		"Str":      vr.Str,
+		"Local":    vr.Local,
+		"Exported": vr.Exported,
+		"ReadOnly": vr.ReadOnly,
+	}
+	switch vr.Kind {
+	case expand.Indexed:
+		list := make([]any, len(vr.List))
+		for i, item := range vr.List {
+			list[i] = item
+		}
+		value["List"] = list
+	case expand.Associative:
+		m := make(map[string]any, len(vr.Map))
+		for k, v := range vr.Map {
+			m[k] = v
+		}
+		value["Map"] = m
+	}
+	return js.ValueOf(value)
+}
+
+func jsToStrings(value js.Value) []string {
+	if value.Type() != js.TypeObject {
+		return nil
+	}
+	strs := make([]string, value.Length())
+	for i := range strs {
+		strs[i] = jsString(value.Index(i))
+	}
+	return strs
+}
+
+// `jsInt` is 0 unless `value` is a number.
+func jsInt(value js.Value) int {
+	if value.Type() != js.TypeNumber {
+		return 0
+	}
+	return value.Int()
+}
+
+func jsString(value js.Value) string {
+	switch value.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		return ""
+	case js.TypeString:
+		return value.String()
+	default:
+		return js.Global().Call("String", value).String()
+	}
+}
+
+// `runHost` provides the callbacks of `processor.Runner`, also reusing `getVar`, `setVar`, `varNames` and `readDir`:
+//   - `exec(args, dir)` returns `{ Status, Stdout, Stderr }`
+//   - `readFile(path)` returns the file's contents
+//   - `writeFile(path, data, append)`
+//   - `stat(path)` returns `{ Name, IsDir, Size }`, or `null` if there is no such file
+func runHost() processor.RunHost {
+	eh := evalHost()
+	runHost := processor.RunHost{Env: eh.Env, ReadDir: eh.ReadDir}
+	if _, ok := hostFunc("exec"); ok {
+		runHost.Exec = func(args []string, dir string) (int, string, string, error) {
+			jsArgs := make([]any, len(args))
+			for i, arg := range args {
+				jsArgs[i] = arg
+			}
+			value, err := hostCall("exec", jsArgs, dir)
+			if err != nil {
+				return 0, "", "", err
+			}
+			if value.Type() != js.TypeObject {
+				return 0, "", "", nil
+			}
+			return jsInt(value.Get("Status")), jsString(value.Get("Stdout")), jsString(value.Get("Stderr")), nil
+		}
+	}
+	if _, ok := hostFunc("readFile"); ok {
+		runHost.ReadFile = func(path string) (string, error) {
+			value, err := hostCall("readFile", path)
+			if err != nil {
+				return "", err
+			}
+			if value.Type() != js.TypeString {
+				return "", fs.ErrNotExist
+			}
+			return value.String(), nil
+		}
+	}
+	if _, ok := hostFunc("writeFile"); ok {
+		runHost.WriteFile = func(path string, data string, append bool) error {
+			_, err := hostCall("writeFile", path, data, append)
+			return err
+		}
+	}
+	if _, ok := hostFunc("stat"); ok {
+		runHost.Stat = func(path string) (fs.FileInfo, error) {
+			value, err := hostCall("stat", path)
+			if err != nil {
+				return nil, err
+			}
+			if value.Type() != js.TypeObject {
+				return nil, &fs.PathError{Op: "stat", Path: path, Err: fs.ErrNotExist}
+			}
+			return processor.HostFileInfo{
+				FileName: jsString(value.Get("Name")),
+				Dir:      value.Get("IsDir").Truthy(),
+				FileSize: int64(jsInt(value.Get("Size"))),
+			}, nil
+		}
+	}
+	return runHost
+}
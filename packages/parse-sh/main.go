@@ -6,11 +6,15 @@ package main
 import (
 	"container/list"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
 	// "github.com/un-ts/sh-syntax/processor"
 	"github.com/rob-myers/npc-cli-vite/packages/cli/processor"
 
+	"mvdan.cc/sh/v3/pattern"
 	"mvdan.cc/sh/v3/syntax"
 )
 
@@ -74,6 +78,9 @@ func parse(
 	variant int,
 	stopAt []byte,
 	recoverErrors int,
+	aliasesBytes []byte, // JSON object from alias name to value
+	limitsBytes []byte, // JSON processor.ParseLimits
+	nodeTable bool,
 
  ) *byte {
 	filepath := string(filepathBytes)
@@ -84,34 +91,36 @@ func parse(
 		Variant:       syntax.LangVariant(variant),
 		StopAt:        string(stopAt),
 		RecoverErrors: recoverErrors,
+		NodeTable:     nodeTable,
 	}
 
-	var file processor.File
-	var error error
-
-	astFile, err := Parse(text, filepath, parserOptions)
-	file = processor.MapFile(*astFile)
-	error = err
-
-	parseError, message := processor.MapParseError(error)
-
-	result := processor.Result{
-		File:       file,
-		Text:       text,
-		ParseError: parseError,
-		Message:    message,
+	aliases, err := unmarshalAliases(aliasesBytes)
+	if err == nil && len(limitsBytes) > 0 {
+		err = easyjson.Unmarshal(limitsBytes, &parserOptions.Limits)
 	}
-
-	bytes, err := easyjson.Marshal(&result)
-
 	if err != nil {
-		fmt.Println(err)
-		bytes = []byte(err.Error())
+		result := processor.Result{Text: text, Aliases: []processor.AliasExpansion{}, Message: err.Error()}
+		return marshalResult(&result)
 	}
+	parserOptions.Aliases = aliases
 
-	bytes = append(bytes, 0)
+	result := parseText(text, filepath, parserOptions)
+	return marshalResult(&result)
+}
 
-	return &bytes[0]
+// `parseText` parses and expands aliases, as `processor.Result`.
+func parseText(text string, filepath string, parserOptions processor.ParserOptions) processor.Result {
+	variant, variantSource := processor.ResolveVariant(text, filepath, parserOptions.Variant)
+	parserOptions.Variant = variant
+
+	astFile, err := Parse(text, filepath, parserOptions)
+	expansions := []processor.AliasExpansion{}
+	if err == nil {
+		astFile, text, expansions, err = processor.ExpandAliases(astFile, text, filepath, parserOptions)
+	}
+	result := mapResult(astFile, err, text, expansions, parserOptions)
+	result.Variant, result.VariantSource = int(variant), variantSource
+	return result
 }
 
 //export interactiveParse
@@ -123,6 +132,9 @@ func interactiveParse(
 	variant int,
 	stopAt []byte,
 	recoverErrors int,
+	aliasesBytes []byte, // JSON object from alias name to value
+	limitsBytes []byte, // JSON processor.ParseLimits
+	nodeTable bool,
 
  ) *byte {
 
@@ -134,10 +146,20 @@ func interactiveParse(
 		Variant:       syntax.LangVariant(variant),
 		// StopAt:        string(stopAt),
 		RecoverErrors: recoverErrors,
+		NodeTable:     nodeTable,
 	}
 
-	var file processor.File
-	var error error
+	aliases, err := unmarshalAliases(aliasesBytes)
+	if err == nil && len(limitsBytes) > 0 {
+		err = easyjson.Unmarshal(limitsBytes, &parserOptions.Limits)
+	}
+	if err != nil {
+		result := processor.Result{Text: text, Aliases: []processor.AliasExpansion{}, Message: err.Error()}
+		return marshalResult(&result)
+	}
+	parserOptions.Aliases = aliases
+	var variantSource string
+	parserOptions.Variant, variantSource = processor.ResolveVariant(text, filepath, parserOptions.Variant)
 
 	astFile, err := InteractiveParse(text, filepath, parserOptions)
 
@@ -145,19 +167,65 @@ func interactiveParse(
 		return nil;
 	}
 
-	file = processor.MapFile(*astFile)
-	error = err
+	expansions := []processor.AliasExpansion{}
+	if err == nil {
+		astFile, text, expansions, err = processor.ExpandAliases(astFile, text, filepath, parserOptions)
+	}
+	result := mapResult(astFile, err, text, expansions, parserOptions)
+	result.Variant, result.VariantSource = int(parserOptions.Variant), variantSource
+	return marshalResult(&result)
+ }
 
-	parseError, message := processor.MapParseError(error)
+// `mapResult` maps a parse as `processor.Result`, enforcing the mapping and output limits.
+// An exceeded limit is reported as `LimitError` instead of a syntax tree.
+func mapResult(
+	astFile *syntax.File,
+	err error,
+	text string,
+	expansions []processor.AliasExpansion,
+	parserOptions processor.ParserOptions,
+) processor.Result {
+	result := processor.Result{Text: text, Aliases: expansions}
+	limits := parserOptions.Limits
+
+	if limitErr, ok := err.(*processor.LimitError); ok {
+		result.File = processor.MapFile(*astFile)
+		result.LimitError, result.Message = limitErr, limitErr.Error()
+		return result
+	}
 
-	result := processor.Result{
-		File:       file,
-		Text:       text,
-		ParseError: parseError,
-		Message:    message,
+	result.File, result.LimitError = processor.MapFileLimited(*astFile, limits)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if result.LimitError != nil {
+		result.Message = result.LimitError.Error()
+	} else if parserOptions.NodeTable {
+		result.Nodes = processor.NodeTable(&result.File)
 	}
 
+	if limits.MaxOutputBytes <= 0 {
+		return result
+	}
+	// measured by marshalling, so the result is marshalled twice
 	bytes, err := easyjson.Marshal(&result)
+	if err == nil && len(bytes) > limits.MaxOutputBytes {
+		limitErr := &processor.LimitError{
+			Kind:    "OutputBytes",
+			Limit:   limits.MaxOutputBytes,
+			Message: "output of " + strconv.Itoa(len(bytes)) + " bytes exceeds the limit of " + strconv.Itoa(limits.MaxOutputBytes),
+		}
+		result = processor.Result{
+			File:       processor.MapFile(syntax.File{Name: astFile.Name}),
+			Aliases:    []processor.AliasExpansion{},
+			LimitError: limitErr,
+			Message:    limitErr.Message,
+		}
+	}
+	return result
+}
+
+// `marshalResult` marshals an export's result as null-terminated JSON, returning a pointer to its first byte.
+func marshalResult(result easyjson.Marshaler) *byte {
+	bytes, err := easyjson.Marshal(result)
 
 	if err != nil {
 		fmt.Println(err)
@@ -167,7 +235,629 @@ func interactiveParse(
 	bytes = append(bytes, 0)
 
 	return &bytes[0]
- }
+}
+
+// `evalExpr` evaluates an arithmetic (mode 0) or conditional (mode 1) expression
+//
+// Variables, file tests and command substitutions are delegated to the JS host, see `host.go`.
+//
+//export evalExpr
+func evalExpr(
+	textBytes []byte,
+	mode int,
+) *byte {
+	result := processor.Eval(string(textBytes), processor.EvalMode(mode), evalHost())
+	return marshalResult(&result)
+}
+
+// `expandWords` expands a list of words e.g. `"$x" ${a[@]} ~/foo` as fields (mode 0),
+// literals (1), here-document bodies (2) or patterns (3)
+//
+// Variables and command substitutions are delegated to the JS host, see `host.go`.
+//
+//export expandWords
+func expandWords(
+	textBytes []byte,
+	mode int,
+) *byte {
+	result := processor.Expand(string(textBytes), processor.ExpandMode(mode), evalHost())
+	return marshalResult(&result)
+}
+
+// `globWords` expands words like `expandWords`, additionally expanding globs e.g. `/home/*.sh` or `**/config`
+//
+// Directories are listed via the JS host, see `host.go`.
+// The flags are a bitmask: 1 extglob, 2 globstar, 4 nullglob, 8 nocaseglob, 16 dotglob.
+//
+//export globWords
+func globWords(
+	textBytes []byte,
+	cwdBytes []byte,
+	flags int,
+) *byte {
+	opts := processor.GlobOptions{
+		ExtGlob:    flags&1 != 0,
+		GlobStar:   flags&2 != 0,
+		NullGlob:   flags&4 != 0,
+		NoCaseGlob: flags&8 != 0,
+		DotGlob:    flags&16 != 0,
+		Cwd:        string(cwdBytes),
+	}
+	result := processor.Glob(string(textBytes), opts, evalHost())
+	return marshalResult(&result)
+}
+
+// `patternRegexp` converts a shell pattern e.g. from `case` or `[[ x == pat ]]` into a JS regular expression
+//
+// The mode is a `pattern.Mode` bitmask, optionally including `processor.ExtGlob`.
+//
+//export patternRegexp
+func patternRegexp(
+	patternBytes []byte,
+	mode int,
+) *byte {
+	var result processor.PatternResult
+	expr, flags, err := processor.PatternRegexp(string(patternBytes), pattern.Mode(mode), true)
+	if err != nil {
+		result.Error = &processor.EvalError{Kind: "BadPattern", Message: err.Error()}
+	} else {
+		result.Regexp, result.Flags = expr, flags
+	}
+	return marshalResult(&result)
+}
+
+// `unmarshalStrings` decodes a JSON array of strings.
+func unmarshalStrings(data []byte) ([]string, error) {
+	in := jlexer.Lexer{Data: data}
+	strs := []string{}
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		strs = append(strs, in.String())
+		in.WantComma()
+	}
+	in.Delim(']')
+	in.Consumed()
+	return strs, in.Error()
+}
+
+// `unmarshalAliases` decodes a JSON object of strings, where empty input means no aliases.
+func unmarshalAliases(data []byte) (map[string]string, error) {
+	aliases := map[string]string{}
+	if len(data) == 0 {
+		return aliases, nil
+	}
+	in := jlexer.Lexer{Data: data}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		name := in.String()
+		in.WantColon()
+		aliases[name] = in.String()
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+	return aliases, in.Error()
+}
+
+// `quote` quotes each value of a JSON array of strings for the given variant
+//
+// Each value reparses to itself as a single word, else its error is reported e.g. for NUL bytes.
+//
+//export quote
+func quote(
+	valuesBytes []byte,
+	variant int,
+) *byte {
+	values, err := unmarshalStrings(valuesBytes)
+	if err != nil {
+		result := processor.QuoteResult{Error: &processor.QuoteError{ByteOffset: -1, Message: err.Error()}}
+		return marshalResult(&result)
+	}
+	result := processor.Quote(values, syntax.LangVariant(variant))
+	return marshalResult(&result)
+}
+
+// `desugar` parses then rewrites into a smaller core language e.g. `let` as `(( … ))`
+//
+// The bitmask `passes` selects `processor.DesugarPass`es, where `0` means `processor.PassAll`.
+//
+//export desugar
+func desugar(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	passes int,
+) *byte {
+	parserOptions := processor.ParserOptions{
+		KeepComments: true,
+		Variant:      syntax.LangVariant(variant),
+	}
+
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	parseError, message := processor.MapParseError(err)
+	result := processor.DesugarResult{
+		Changes:    []processor.Rewrite{},
+		ParseError: parseError,
+		Message:    message,
+	}
+	if err == nil {
+		result.Changes = processor.Desugar(astFile, processor.DesugarPass(passes))
+		file := processor.MapFile(*astFile)
+		result.File = &file
+
+		var buf strings.Builder
+		if err := syntax.NewPrinter().Print(&buf, astFile); err == nil {
+			result.Text = buf.String()
+		}
+	}
+	return marshalResult(&result)
+}
+
+// `lint` parses then runs the lint rules, where `optionsBytes` is JSON `processor.LintOptions`
+//
+//export lint
+func lint(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	optionsBytes []byte,
+) *byte {
+	result := processor.LintResult{Diagnostics: []processor.Diagnostic{}}
+
+	var options processor.LintOptions
+	if len(optionsBytes) > 0 {
+		if err := easyjson.Unmarshal(optionsBytes, &options); err != nil {
+			result.Message = err.Error()
+			return marshalResult(&result)
+		}
+	}
+
+	parserOptions := processor.ParserOptions{
+		KeepComments: true,
+		Variant:      syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result.Diagnostics = processor.Lint(astFile, options)
+	}
+	return marshalResult(&result)
+}
+
+// `lintRules` lists the registered lint rules
+//
+//export lintRules
+func lintRules() *byte {
+	result := processor.LintRulesResult{Rules: processor.LintRules()}
+	return marshalResult(&result)
+}
+
+// `symbols` parses then collects functions, variables and their definition/use sites
+//
+//export symbols
+func symbols(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+) *byte {
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+
+	var result processor.SymbolTable
+	if err == nil {
+		result = processor.Symbols(astFile)
+	}
+	result.ParseError, result.Message = processor.MapParseError(err)
+	return marshalResult(&result)
+}
+
+// `rename` renames the variable or function under byte `offset`, returning text edits
+//
+//export rename
+func rename(
+	textBytes []byte,
+	offset int,
+	newNameBytes []byte,
+	variant int,
+) *byte {
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), "", parserOptions)
+
+	var result processor.RenameResult
+	if err == nil {
+		result = processor.Rename(astFile, uint(offset), string(newNameBytes))
+	}
+	result.ParseError, result.Message = processor.MapParseError(err)
+	return marshalResult(&result)
+}
+
+// `validate` parses then checks literal commands against JSON `processor.CommandRegistry`
+//
+//export validate
+func validate(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	registryBytes []byte,
+) *byte {
+	result := processor.LintResult{Diagnostics: []processor.Diagnostic{}}
+
+	var registry processor.CommandRegistry
+	if err := easyjson.Unmarshal(registryBytes, &registry); err != nil {
+		result.Message = err.Error()
+		return marshalResult(&result)
+	}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result.Diagnostics = processor.Validate(astFile, registry)
+	}
+	return marshalResult(&result)
+}
+
+// `deps` parses JSON `processor.DepsInput` then extracts a dependency graph between its files
+//
+//export deps
+func deps(inputBytes []byte) *byte {
+	var result processor.DepsGraph
+
+	var input processor.DepsInput
+	if err := easyjson.Unmarshal(inputBytes, &input); err != nil {
+		result.Message = err.Error()
+		return marshalResult(&result)
+	}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(input.Variant),
+	}
+	files := []processor.DepsFile{}
+	parseErrors := make([]*processor.ParseError, len(input.Files))
+	for i, file := range input.Files {
+		astFile, err := Parse(file.Text, file.Path, parserOptions)
+		if err != nil {
+			parseErrors[i], _ = processor.MapParseError(err)
+			continue
+		}
+		files = append(files, processor.DepsFile{Path: file.Path, File: astFile})
+	}
+
+	result = processor.Deps(files, input.Modules, input.Cwd)
+	result.ParseErrors = parseErrors
+	return marshalResult(&result)
+}
+
+// `docs` parses then extracts doc comments of functions, assignments and case items
+//
+//export docs
+func docs(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+) *byte {
+	parserOptions := processor.ParserOptions{
+		KeepComments: true,
+		Variant:      syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+
+	result := processor.DocsResult{Docs: []processor.DocEntry{}}
+	if err == nil {
+		result.Docs = processor.Docs(astFile)
+	}
+	result.ParseError, result.Message = processor.MapParseError(err)
+	return marshalResult(&result)
+}
+
+// `runners` are the live `runnerNew` handles
+var runners = map[int]*processor.Runner{}
+var nextRunner = 1
+
+// `runnerNew` parses a script to be run by mvdan's interpreter, see `runnerStep`
+//
+// The options are JSON `processor.RunOptions`. Callbacks are read from the host on each step.
+//
+//export runnerNew
+func runnerNew(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	optionsBytes []byte,
+) *byte {
+	result := processor.RunResult{Assigns: []processor.VarAssign{}}
+
+	var options processor.RunOptions
+	if len(optionsBytes) > 0 {
+		if err := easyjson.Unmarshal(optionsBytes, &options); err != nil {
+			result.Message = err.Error()
+			return marshalResult(&result)
+		}
+	}
+
+	parserOptions := processor.ParserOptions{Variant: syntax.LangVariant(variant)}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err != nil {
+		return marshalResult(&result)
+	}
+
+	runner, err := processor.NewRunner(astFile, runHost(), options)
+	if err != nil {
+		result.Error = err.Error()
+		return marshalResult(&result)
+	}
+	result = runner.Peek()
+	result.Handle = nextRunner
+	runners[nextRunner] = runner
+	nextRunner++
+	return marshalResult(&result)
+}
+
+// `runnerStep` runs at most `count` top-level statements of a runner, or all remaining if `count` is not positive
+//
+//export runnerStep
+func runnerStep(handle int, count int) *byte {
+	runner, ok := runners[handle]
+	if !ok {
+		result := processor.RunResult{Handle: handle, Done: true, Assigns: []processor.VarAssign{}, Error: "unknown runner"}
+		return marshalResult(&result)
+	}
+	result := runner.Step(count)
+	result.Handle = handle
+	return marshalResult(&result)
+}
+
+// `runnerFree` discards a runner
+//
+//export runnerFree
+func runnerFree(handle int) {
+	delete(runners, handle)
+}
+
+// `checkPolicy` parses then reports constructs forbidden by JSON `processor.Policy`
+//
+//export checkPolicy
+func checkPolicy(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	policyBytes []byte,
+) *byte {
+	result := processor.LintResult{Diagnostics: []processor.Diagnostic{}}
+
+	var policy processor.Policy
+	if err := easyjson.Unmarshal(policyBytes, &policy); err != nil {
+		result.Message = err.Error()
+		return marshalResult(&result)
+	}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result.Diagnostics = processor.CheckPolicy(astFile, policy)
+	}
+	return marshalResult(&result)
+}
+
+// `query` parses then finds the nodes matching a selector e.g. `WhileClause CallExpr[arg0=npc]`
+//
+//export query
+func query(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	selectorBytes []byte,
+) *byte {
+	result := processor.QueryResult{Matches: []processor.QueryMatch{}}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	text := string(textBytes)
+	astFile, err := Parse(text, string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		matches, err := processor.Query(astFile, text, string(selectorBytes))
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Matches = matches
+		}
+	}
+	return marshalResult(&result)
+}
+
+// `diff` parses two versions of a script then compares their top-level functions, assignments and statements
+//
+//export diff
+func diff(
+	filepathBytes []byte,
+	oldTextBytes []byte,
+	newTextBytes []byte,
+	variant int,
+) *byte {
+	result := processor.DiffResult{Changes: []processor.DiffChange{}}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	filepath := string(filepathBytes)
+	oldFile, err := Parse(string(oldTextBytes), filepath, parserOptions)
+	if err != nil {
+		result.ParseError, result.Message = processor.MapParseError(err)
+		result.ParseErrorInOld = true
+		return marshalResult(&result)
+	}
+	newFile, err := Parse(string(newTextBytes), filepath, parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result.Changes = processor.Diff(oldFile, newFile)
+	}
+	return marshalResult(&result)
+}
+
+// `parseBatch` parses many scripts in one call, given JSON `processor.Batch`
+//
+// Each item has its own result and errors, so one invalid script does not affect the others.
+//
+//export parseBatch
+func parseBatch(
+	batchBytes []byte,
+) *byte {
+	result := processor.BatchResult{Results: []processor.Result{}}
+
+	var batch processor.Batch
+	if err := easyjson.Unmarshal(batchBytes, &batch); err != nil {
+		result.Message = err.Error()
+		return marshalResult(&result)
+	}
+
+	for _, item := range batch.Items {
+		result.Results = append(result.Results, parseText(item.Text, item.Name, batch.ParserOptions(item)))
+	}
+	return marshalResult(&result)
+}
+
+// `checkPortability` parses then lists constructs not supported by a target variant e.g. bashisms given posix (1)
+//
+//export checkPortability
+func checkPortability(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	target int,
+) *byte {
+	result := processor.PortabilityResult{Issues: []processor.PortabilityIssue{}, Variants: []string{}}
+
+	text := string(textBytes)
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(text, string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result = processor.CheckPortability(astFile, text, syntax.LangVariant(target))
+	}
+	return marshalResult(&result)
+}
+
+// `checkCapabilities` parses then reports the nodes and operators unsupported by a runtime, given JSON `processor.Capabilities`
+//
+//export checkCapabilities
+func checkCapabilities(
+	filepathBytes []byte,
+	textBytes []byte,
+	variant int,
+	capabilitiesBytes []byte,
+) *byte {
+	result := processor.LintResult{Diagnostics: []processor.Diagnostic{}}
+
+	var capabilities processor.Capabilities
+	if err := easyjson.Unmarshal(capabilitiesBytes, &capabilities); err != nil {
+		result.Message = err.Error()
+		return marshalResult(&result)
+	}
+
+	parserOptions := processor.ParserOptions{
+		Variant: syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		result.Diagnostics = processor.CheckCapabilities(astFile, capabilities)
+	}
+	return marshalResult(&result)
+}
+
+// `lineTable` parses then lists the executable statements and branches, by the node IDs of `parse` given `keepComments`
+//
+//export lineTable
+func lineTable(
+	filepathBytes []byte,
+	textBytes []byte,
+	keepComments bool,
+	variant int,
+) *byte {
+	result := processor.LineTableResult{Entries: []processor.LineEntry{}, Branches: []processor.Branch{}}
+
+	parserOptions := processor.ParserOptions{
+		KeepComments: keepComments,
+		Variant:      syntax.LangVariant(variant),
+	}
+	astFile, err := Parse(string(textBytes), string(filepathBytes), parserOptions)
+	result.ParseError, result.Message = processor.MapParseError(err)
+	if err == nil {
+		mapped := processor.MapFile(*astFile)
+		result = processor.LineTable(astFile, &mapped)
+	}
+	return marshalResult(&result)
+}
+
+// `streams` are the live `stmtStreamNew` handles
+var streams = map[int]*processor.StmtStream{}
+var nextStream = 1
+
+// `stmtStreamNew` starts parsing a script statement by statement, see `nextStmt`
+//
+//export stmtStreamNew
+func stmtStreamNew(
+	filepathBytes []byte,
+	textBytes []byte,
+	keepComments bool,
+	variant int,
+	aliasesBytes []byte, // JSON object from alias name to value
+	limitsBytes []byte, // JSON processor.ParseLimits
+) *byte {
+	parserOptions := processor.ParserOptions{
+		KeepComments: keepComments,
+		Variant:      syntax.LangVariant(variant),
+	}
+	aliases, err := unmarshalAliases(aliasesBytes)
+	if err == nil && len(limitsBytes) > 0 {
+		err = easyjson.Unmarshal(limitsBytes, &parserOptions.Limits)
+	}
+	if err != nil {
+		result := processor.StmtResult{Done: true, Aliases: []processor.AliasExpansion{}, Message: err.Error()}
+		return marshalResult(&result)
+	}
+	parserOptions.Aliases = aliases
+
+	result := processor.StmtResult{Handle: nextStream, Aliases: []processor.AliasExpansion{}}
+	streams[nextStream] = processor.NewStmtStream(string(textBytes), string(filepathBytes), parserOptions)
+	nextStream++
+	return marshalResult(&result)
+}
+
+// `nextStmt` parses the next top-level statement of a stream, else reports it is done along with any syntax error
+//
+//export nextStmt
+func nextStmt(handle int) *byte {
+	stream, ok := streams[handle]
+	if !ok {
+		result := processor.StmtResult{Handle: handle, Done: true, Aliases: []processor.AliasExpansion{}, Message: "unknown stream"}
+		return marshalResult(&result)
+	}
+	result := stream.Next()
+	result.Handle = handle
+	return marshalResult(&result)
+}
+
+// `stmtStreamFree` discards a stream
+//
+//export stmtStreamFree
+func stmtStreamFree(handle int) {
+	delete(streams, handle)
+}
 
 func main() {
 }
\ No newline at end of file
@@ -0,0 +1,261 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// maxAliasExpansions bounds the expansions of a single parse e.g. given `alias a='a a'`
+// every command `a` expands once, yet many commands might.
+const maxAliasExpansions = 1000
+
+// aliasRegion is the text produced by an alias, in the current text.
+type aliasRegion struct {
+	name, value string
+	pos, end    int
+	// range in the original text, of the outermost expansion
+	origPos, origEnd int
+	parent           int
+	// whether a trailing blank has yet to expand the following word
+	chains bool
+}
+
+// `ExpandAliases` expands `parserOptions.Aliases` at command name positions of `file`,
+// by replacing text and reparsing, until no alias applies. As in bash:
+//   - only unquoted literal command names are expanded, including after assignments e.g. `FOO=1 ll`
+//   - an alias is not expanded within its own expansion, so `alias ls='ls -a'` terminates
+//   - if an alias value ends with a blank, the following word is also checked e.g. `alias sudo='sudo '`
+//
+// Every applicable word is expanded before reparsing, so only aliases within expansions
+// e.g. `alias la='ll -a'` need another pass.
+//
+// It returns the expanded file and text, along with each expansion's range in both texts.
+func ExpandAliases(file *syntax.File, text string, filepath string, parserOptions ParserOptions) (*syntax.File, string, []AliasExpansion, error) {
+	expansions := []AliasExpansion{}
+	if len(parserOptions.Aliases) == 0 {
+		return file, text, expansions, nil
+	}
+
+	original := text
+	regions := []aliasRegion{}
+
+	for len(regions) < maxAliasExpansions {
+		found := findAliases(file, parserOptions.Aliases, regions)
+		if len(found) == 0 {
+			break
+		}
+		found = found[:min(len(found), maxAliasExpansions-len(regions))]
+		text, regions = expandAliasWords(text, regions, found, parserOptions.Aliases)
+
+		var err error
+		if file, err = Parse(text, filepath, parserOptions); err != nil {
+			return file, text, mapAliasRegions(original, text, regions), err
+		}
+	}
+
+	return file, text, mapAliasRegions(original, text, regions), nil
+}
+
+// `aliasWord` is a word to be expanded.
+type aliasWord struct {
+	word *syntax.Word
+	name string
+	// index of the expansion chaining to it, else -1
+	chain int
+	// whether the following word is also expanded, chaining to this one
+	chained bool
+}
+
+// `findAliases` finds the words to be expanded in source order.
+func findAliases(file *syntax.File, aliases map[string]string, regions []aliasRegion) []aliasWord {
+	found := []aliasWord{}
+
+	expandable := func(word *syntax.Word) (string, bool) {
+		lit, ok := aliasName(word)
+		if !ok {
+			return "", false
+		}
+		if _, ok := aliases[lit]; !ok {
+			return "", false
+		}
+		offset := int(word.Pos().Offset())
+		for _, r := range regions {
+			if r.name == lit && r.pos <= offset && offset < r.end {
+				// recursion guard
+				return "", false
+			}
+		}
+		return lit, true
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		call, ok := node.(*syntax.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		// whether the previous word expands to a value ending with a blank
+		blank := false
+		for i, word := range call.Args {
+			chain := -1
+			if i > 0 {
+				chain = chainingAlias(regions, call.Args[i-1], word)
+			}
+			if i > 0 && chain == -1 && !blank {
+				continue
+			}
+			name, ok := expandable(word)
+			if !ok {
+				blank = false
+				continue
+			}
+			if blank {
+				found[len(found)-1].chained = true
+			}
+			found = append(found, aliasWord{word: word, name: name, chain: chain})
+			value := aliases[name]
+			blank = strings.HasSuffix(value, " ") || strings.HasSuffix(value, "\t")
+		}
+		return true
+	})
+
+	// e.g. a command substitution within an earlier argument
+	slices.SortFunc(found, func(a, b aliasWord) int {
+		return int(a.word.Pos().Offset()) - int(b.word.Pos().Offset())
+	})
+	return found
+}
+
+// `expandAliasWords` replaces `found` within `text` in a single pass, recording an expansion for each.
+func expandAliasWords(text string, regions []aliasRegion, found []aliasWord, aliases map[string]string) (string, []aliasRegion) {
+	// `shifted` maps an offset outside the replaced words to the expanded text
+	ends, deltas := make([]int, len(found)), make([]int, len(found))
+	shifted := func(offset int) int {
+		i, _ := slices.BinarySearch(ends, offset+1)
+		if i == 0 {
+			return offset
+		}
+		return offset + deltas[i-1]
+	}
+
+	var sb strings.Builder
+	prev, delta := 0, 0
+	added := make([]aliasRegion, len(found))
+	for i, f := range found {
+		value := aliases[f.name]
+		pos, end := int(f.word.Pos().Offset()), int(f.word.End().Offset())
+		if f.chain != -1 {
+			regions[f.chain].chains = false
+		}
+
+		region := aliasRegion{name: f.name, value: value, parent: -1}
+		region.chains = !f.chained && (strings.HasSuffix(value, " ") || strings.HasSuffix(value, "\t"))
+		for j, r := range regions {
+			if r.pos <= pos && end <= r.end {
+				region.parent = j
+			}
+		}
+		if region.parent == -1 {
+			region.origPos = originalOffset(regions, pos)
+			region.origEnd = region.origPos + end - pos
+		} else {
+			region.origPos = regions[region.parent].origPos
+			region.origEnd = regions[region.parent].origEnd
+		}
+		region.pos = pos + delta
+		region.end = region.pos + len(value)
+		added[i] = region
+
+		sb.WriteString(text[prev:pos])
+		sb.WriteString(value)
+		prev = end
+		delta += len(value) - (end - pos)
+		ends[i], deltas[i] = end, delta
+	}
+	sb.WriteString(text[prev:])
+
+	for i := range regions {
+		regions[i].pos, regions[i].end = shifted(regions[i].pos), shifted(regions[i].end)
+	}
+	return sb.String(), append(regions, added...)
+}
+
+// `chainingAlias` finds an expansion ending with a blank whose last word is `prev`, else -1.
+func chainingAlias(regions []aliasRegion, prev, word *syntax.Word) int {
+	pos, end := int(prev.Pos().Offset()), int(prev.End().Offset())
+	for i, r := range regions {
+		if r.chains && r.pos <= pos && end <= r.end && int(word.Pos().Offset()) >= r.end {
+			return i
+		}
+	}
+	return -1
+}
+
+// `aliasName` returns the literal value of an unquoted word without escapes or expansions.
+func aliasName(word *syntax.Word) (string, bool) {
+	if len(word.Parts) != 1 {
+		return "", false
+	}
+	lit, ok := word.Parts[0].(*syntax.Lit)
+	if !ok || strings.ContainsAny(lit.Value, `\=/`) {
+		return "", false
+	}
+	return lit.Value, true
+}
+
+// `originalOffset` maps an offset outside every expansion back to the original text.
+func originalOffset(regions []aliasRegion, offset int) int {
+	orig := offset
+	for _, r := range regions {
+		if r.parent == -1 && r.end <= offset {
+			orig -= (r.end - r.pos) - (r.origEnd - r.origPos)
+		}
+	}
+	return orig
+}
+
+// `mapAliasRegions` lists expansions in source order, each before those nested within it.
+func mapAliasRegions(original, text string, regions []aliasRegion) []AliasExpansion {
+	// regions are created by pass, so parents precede their children
+	order := make([]int, len(regions))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		if regions[i].pos != regions[j].pos {
+			return regions[i].pos - regions[j].pos
+		}
+		return regions[j].end - regions[i].end
+	})
+	index := make([]int, len(regions))
+	for i, r := range order {
+		index[r] = i
+	}
+
+	expansions := make([]AliasExpansion, len(regions))
+	for i, r := range regions {
+		parent := r.parent
+		if parent != -1 {
+			parent = index[parent]
+		}
+		expansions[index[i]] = AliasExpansion{
+			Name:    r.name,
+			Value:   r.value,
+			Parent:  parent,
+			Pos:     posAt(text, r.pos),
+			End:     posAt(text, r.end),
+			OrigPos: posAt(original, r.origPos),
+			OrigEnd: posAt(original, r.origEnd),
+		}
+	}
+	return expansions
+}
+
+// `posAt` computes the line and column of a byte offset, as the parser would.
+func posAt(text string, offset int) Pos {
+	before := text[:offset]
+	line := strings.Count(before, "\n")
+	col := offset - (strings.LastIndexByte(before, '\n') + 1)
+	return Pos{Offset: uint(offset), Line: uint(line + 1), Col: uint(col + 1)}
+}
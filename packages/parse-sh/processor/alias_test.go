@@ -0,0 +1,54 @@
+package processor
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpandAliases(t *testing.T) {
+	aliases := map[string]string{
+		"ll":   "ls -l",
+		"la":   "ll -a",
+		"ls":   "ls --color",
+		"sudo": "sudo ",
+		"a":    "a a",
+		"x":    "echo x; ll",
+	}
+	tests := []struct {
+		text     string
+		expanded string
+		// name, parent and original range of each expansion
+		expansions string
+	}{
+		{"ll", "ls --color -l", "[ll -1 0-2 ls 0 0-2]"},
+		{"ll; ll\nll", "ls --color -l; ls --color -l\nls --color -l", "[ll -1 0-2 ls 0 0-2 ll -1 4-6 ls 2 4-6 ll -1 7-9 ls 4 7-9]"},
+		{"la", "ls --color -l -a", "[la -1 0-2 ll 0 0-2 ls 1 0-2]"},
+		{"sudo sudo ll x", "sudo  sudo  ls -l x", "[sudo -1 0-4 sudo -1 5-9 ll -1 10-12]"},
+		{"sudo a ll", "sudo  a a ll", "[sudo -1 0-4 a -1 5-6]"},
+		{"x", "echo x; ls --color -l", "[x -1 0-1 ll 0 0-1 ls 1 0-1]"},
+		{"FOO=1 ll 'll'", "FOO=1 ls --color -l 'll'", "[ll -1 6-8 ls 0 6-8]"},
+		{"echo $(ll) `la`", "echo $(ls --color -l) `ls --color -l -a`", "[ll -1 7-9 ls 0 7-9 la -1 12-14 ll 2 12-14 ls 3 12-14]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, text, expansions, err := ExpandAliases(file, tt.text, "", ParserOptions{Aliases: aliases})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if text != tt.expanded {
+				t.Errorf("text = %q, want %q", text, tt.expanded)
+			}
+			got := []string{}
+			for _, exp := range expansions {
+				got = append(got, fmt.Sprintf("%s %d %d-%d", exp.Name, exp.Parent, exp.OrigPos.Offset, exp.OrigEnd.Offset))
+			}
+			if fmt.Sprint(got) != tt.expansions {
+				t.Errorf("expansions = %v, want %s", got, tt.expansions)
+			}
+		})
+	}
+}
@@ -0,0 +1,829 @@
+package processor
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/expand"
+	"mvdan.cc/sh/v3/pattern"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+type EvalMode int
+
+const (
+	// e.g. `i++, x += 2` or `(( x > 1 ))` or `$(( 16#ff ))` or `let x=1 y=x+1`
+	EvalArithm EvalMode = iota
+	// e.g. `-n $x && $y == a*` or `[[ -f /home/foo ]]`
+	EvalTest
+)
+
+// `EvalHost` provides what shell evaluation cannot compute on its own.
+type EvalHost struct {
+	Env *HostEnviron
+	// `FileTest` answers unary file tests e.g. `-f x` (y is empty),
+	// and binary ones e.g. `x -nt y`.
+	FileTest func(op string, x, y string) (bool, error)
+	// `OptionSet` answers `-o optname`.
+	OptionSet func(name string) bool
+	// `CmdSubst` runs a command substitution, returning its stdout.
+	CmdSubst func(text string) (string, error)
+	// `ReadDir` lists a directory of the (virtual) filesystem, for pathname expansion.
+	ReadDir func(path string) ([]fs.DirEntry, error)
+}
+
+const maxArithmDepth = 1024
+
+type evaluator struct {
+	host EvalHost
+	cfg  *expand.Config
+	text string
+	// offset of `text` inside the parsed source, e.g. 3 for "[[ " prefix
+	shift uint
+	depth int
+}
+
+func (e *EvalError) Error() string {
+	return e.Message
+}
+
+// `Eval` evaluates an arithmetic or conditional expression using bash integer semantics.
+//
+// The text may be a bare expression, interpreted according to `mode`, or a complete
+// `(( … ))`, `$(( … ))`, `let …` or `[[ … ]]` command e.g. the source of a mapped node.
+// Variables are read and written through `host.Env`, and every write is reported.
+func Eval(text string, mode EvalMode, host EvalHost) EvalResult {
+	if host.Env == nil {
+		host.Env = &HostEnviron{}
+	}
+	e := &evaluator{host: host, text: text}
+	e.cfg = &expand.Config{
+		Env:      host.Env,
+		CmdSubst: e.cmdSubst,
+	}
+
+	value, status, err := e.evalText(mode)
+	result := EvalResult{
+		Value:   value,
+		Status:  status,
+		Assigns: host.Env.Assigns,
+	}
+	if err != nil {
+		result.Error = e.mapError(err)
+	}
+	return result
+}
+
+func (e *evaluator) evalText(mode EvalMode) (int64, int, error) {
+	trimmed := strings.TrimSpace(e.text)
+
+	switch {
+	case strings.HasPrefix(trimmed, "$(("):
+		word, err := syntax.NewParser().Document(strings.NewReader(e.text))
+		if err != nil {
+			return 0, 1, err
+		}
+		for _, part := range word.Parts {
+			if exp, ok := part.(*syntax.ArithmExp); ok {
+				value, err := e.arithm(exp.X)
+				return value, statusOf(value, err), err
+			}
+		}
+		return 0, 1, &EvalError{Kind: "Syntax", Message: "expected $(( … ))"}
+
+	case strings.HasPrefix(trimmed, "(("),
+		strings.HasPrefix(trimmed, "[["),
+		strings.HasPrefix(trimmed, "let ") || strings.HasPrefix(trimmed, "let\t"):
+		file, err := syntax.NewParser().Parse(strings.NewReader(e.text), "")
+		if err != nil {
+			return 0, 2, err
+		}
+		if len(file.Stmts) != 1 {
+			return 0, 2, &EvalError{Kind: "Syntax", Message: "expected a single command"}
+		}
+		return e.evalCommand(file.Stmts[0].Cmd)
+
+	case mode == EvalTest:
+		const prefix = "[[ "
+		file, err := syntax.NewParser().Parse(strings.NewReader(prefix+e.text+" ]]"), "")
+		e.shift = uint(len(prefix))
+		if err != nil {
+			return 0, 2, err
+		}
+		if len(file.Stmts) != 1 {
+			return 0, 2, &EvalError{Kind: "Syntax", Message: "expected a single test expression"}
+		}
+		return e.evalCommand(file.Stmts[0].Cmd)
+
+	default:
+		expr, err := syntax.NewParser().Arithmetic(strings.NewReader(e.text))
+		if err != nil {
+			return 0, 1, err
+		}
+		value, err := e.arithm(expr)
+		return value, statusOf(value, err), err
+	}
+}
+
+func (e *evaluator) evalCommand(cmd syntax.Command) (int64, int, error) {
+	switch cmd := cmd.(type) {
+	case *syntax.ArithmCmd:
+		value, err := e.arithm(cmd.X)
+		return value, statusOf(value, err), err
+	case *syntax.LetClause:
+		var value int64
+		for _, expr := range cmd.Exprs {
+			var err error
+			if value, err = e.arithm(expr); err != nil {
+				return 0, 1, err
+			}
+		}
+		return value, statusOf(value, nil), nil
+	case *syntax.TestClause:
+		ok, err := e.test(cmd.X)
+		if err != nil {
+			return 0, 2, err
+		}
+		if ok {
+			return 1, 0, nil
+		}
+		return 0, 1, nil
+	default:
+		return 0, 2, &EvalError{Kind: "Syntax", Message: "expected (( … )), let or [[ … ]]"}
+	}
+}
+
+// `statusOf` is the exit status of `(( … ))` or `let` with final value `value`.
+func statusOf(value int64, err error) int {
+	if err != nil || value == 0 {
+		return 1
+	}
+	return 0
+}
+
+func (e *evaluator) cmdSubst(w io.Writer, cs *syntax.CmdSubst) error {
+	if e.host.CmdSubst == nil {
+		return expand.UnexpectedCommandError{Node: cs}
+	}
+	src := e.source(cs.Pos(), cs.End())
+	out, err := e.host.CmdSubst(src)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// `source` returns the original text between two positions of the parsed input.
+func (e *evaluator) source(pos, end syntax.Pos) string {
+	from, to := pos.Offset()-e.shift, end.Offset()-e.shift
+	if pos.Offset() < e.shift || to > uint(len(e.text)) || from > to {
+		return ""
+	}
+	return e.text[from:to]
+}
+
+func (e *evaluator) errorAt(node syntax.Node, kind, format string, args ...any) *EvalError {
+	return &EvalError{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+		Pos:     e.mapPos(node.Pos()),
+		End:     e.mapPos(node.End()),
+	}
+}
+
+// `mapPos` undoes any prefix we added before parsing.
+func (e *evaluator) mapPos(pos syntax.Pos) Pos {
+	mapped := mapPos(pos)
+	if mapped.Offset >= e.shift {
+		mapped.Offset -= e.shift
+	}
+	if mapped.Line == 1 && mapped.Col > e.shift {
+		mapped.Col -= e.shift
+	}
+	return mapped
+}
+
+func (e *evaluator) mapError(err error) *EvalError {
+	switch err := err.(type) {
+	case *EvalError:
+		return err
+	case syntax.ParseError:
+		return &EvalError{Kind: "Syntax", Message: err.Text, Pos: e.mapPos(err.Pos), End: e.mapPos(err.Pos)}
+	case expand.UnexpectedCommandError:
+		return e.errorAt(err.Node, "CmdSubst", "%s", "command substitution is not available")
+	case expand.UnsetParameterError:
+		return e.errorAt(err.Node, "Unset", "%s", err.Error())
+	default:
+		return &EvalError{Kind: "Expansion", Message: err.Error()}
+	}
+}
+
+// `arithm` follows `expand.Arithm`, which cannot be used as of v3.11: it computes with `int`,
+// i.e. 32 bits under wasm, ignores malformed numbers, `16#ff`, `0x1f` and `017`, and neither
+// short-circuits `&&` nor `||`. Nor can it treat `2 ? x : y` as true, assign to `a[i]`,
+// evaluate variables holding expressions e.g. `x=y+1`, or position its errors.
+func (e *evaluator) arithm(expr syntax.ArithmExpr) (int64, error) {
+	if e.depth++; e.depth > maxArithmDepth {
+		return 0, e.errorAt(expr, "Recursion", "expression recursion level exceeded")
+	}
+	defer func() { e.depth-- }()
+
+	switch expr := expr.(type) {
+	case *syntax.Word:
+		str, err := expand.Literal(e.cfg, expr)
+		if err != nil {
+			return 0, err
+		}
+		return e.arithmValue(expr, str)
+
+	case *syntax.ParenArithm:
+		return e.arithm(expr.X)
+
+	case *syntax.UnaryArithm:
+		switch expr.Op {
+		case syntax.Inc, syntax.Dec:
+			name, ok := e.lvalue(expr.X)
+			if !ok {
+				return 0, e.errorAt(expr, "Syntax", "%s", "attempted assignment to non-variable")
+			}
+			old, err := e.varValue(expr.X, name)
+			if err != nil {
+				return 0, err
+			}
+			value := old + 1
+			if expr.Op == syntax.Dec {
+				value = old - 1
+			}
+			if err := e.setVar(expr.X, name, value); err != nil {
+				return 0, err
+			}
+			if expr.Post {
+				return old, nil
+			}
+			return value, nil
+		}
+		value, err := e.arithm(expr.X)
+		if err != nil {
+			return 0, err
+		}
+		switch expr.Op {
+		case syntax.Not:
+			return oneIf(value == 0), nil
+		case syntax.BitNegation:
+			return ^value, nil
+		case syntax.Plus:
+			return value, nil
+		default: // syntax.Minus
+			return -value, nil
+		}
+
+	case *syntax.BinaryArithm:
+		switch expr.Op {
+		case syntax.Assgn, syntax.AddAssgn, syntax.SubAssgn,
+			syntax.MulAssgn, syntax.QuoAssgn, syntax.RemAssgn,
+			syntax.AndAssgn, syntax.OrAssgn, syntax.XorAssgn,
+			syntax.ShlAssgn, syntax.ShrAssgn:
+			return e.assign(expr)
+		case syntax.TernQuest: // TernColon can't happen here
+			cond, err := e.arithm(expr.X)
+			if err != nil {
+				return 0, err
+			}
+			branches := expr.Y.(*syntax.BinaryArithm) // must have Op==TernColon
+			if cond != 0 {
+				return e.arithm(branches.X)
+			}
+			return e.arithm(branches.Y)
+		case syntax.AndArit, syntax.OrArit:
+			// short-circuit, so `x && y++` only increments when x is non-zero
+			left, err := e.arithm(expr.X)
+			if err != nil {
+				return 0, err
+			}
+			if (expr.Op == syntax.AndArit) == (left == 0) {
+				return oneIf(left != 0), nil
+			}
+			right, err := e.arithm(expr.Y)
+			if err != nil {
+				return 0, err
+			}
+			return oneIf(right != 0), nil
+		}
+		left, err := e.arithm(expr.X)
+		if err != nil {
+			return 0, err
+		}
+		right, err := e.arithm(expr.Y)
+		if err != nil {
+			return 0, err
+		}
+		return e.binary(expr, left, right)
+
+	default:
+		return 0, e.errorAt(expr, "Syntax", "unexpected arithmetic expression %T", expr)
+	}
+}
+
+func (e *evaluator) assign(expr *syntax.BinaryArithm) (int64, error) {
+	name, ok := e.lvalue(expr.X)
+	if !ok {
+		return 0, e.errorAt(expr, "Syntax", "%s", "attempted assignment to non-variable")
+	}
+	arg, err := e.arithm(expr.Y)
+	if err != nil {
+		return 0, err
+	}
+	value := arg
+	if expr.Op != syntax.Assgn {
+		old, err := e.varValue(expr.X, name)
+		if err != nil {
+			return 0, err
+		}
+		op := map[syntax.BinAritOperator]syntax.BinAritOperator{
+			syntax.AddAssgn: syntax.Add,
+			syntax.SubAssgn: syntax.Sub,
+			syntax.MulAssgn: syntax.Mul,
+			syntax.QuoAssgn: syntax.Quo,
+			syntax.RemAssgn: syntax.Rem,
+			syntax.AndAssgn: syntax.And,
+			syntax.OrAssgn:  syntax.Or,
+			syntax.XorAssgn: syntax.Xor,
+			syntax.ShlAssgn: syntax.Shl,
+			syntax.ShrAssgn: syntax.Shr,
+		}[expr.Op]
+		if value, err = e.binary(&syntax.BinaryArithm{OpPos: expr.OpPos, Op: op, X: expr.X, Y: expr.Y}, old, arg); err != nil {
+			return 0, err
+		}
+	}
+	if err := e.setVar(expr.X, name, value); err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+func (e *evaluator) binary(expr *syntax.BinaryArithm, x, y int64) (int64, error) {
+	switch expr.Op {
+	case syntax.Add:
+		return x + y, nil
+	case syntax.Sub:
+		return x - y, nil
+	case syntax.Mul:
+		return x * y, nil
+	case syntax.Quo, syntax.Rem:
+		if y == 0 {
+			return 0, e.errorAt(expr, "DivisionByZero", "%s", "division by 0")
+		}
+		if expr.Op == syntax.Quo {
+			return x / y, nil
+		}
+		return x % y, nil
+	case syntax.Pow:
+		if y < 0 {
+			return 0, e.errorAt(expr, "NegativeExponent", "%s", "exponent less than 0")
+		}
+		return intPow(x, y), nil
+	case syntax.Eql:
+		return oneIf(x == y), nil
+	case syntax.Gtr:
+		return oneIf(x > y), nil
+	case syntax.Lss:
+		return oneIf(x < y), nil
+	case syntax.Neq:
+		return oneIf(x != y), nil
+	case syntax.Leq:
+		return oneIf(x <= y), nil
+	case syntax.Geq:
+		return oneIf(x >= y), nil
+	case syntax.And:
+		return x & y, nil
+	case syntax.Or:
+		return x | y, nil
+	case syntax.Xor:
+		return x ^ y, nil
+	case syntax.Shr:
+		return x >> (uint64(y) & 63), nil
+	case syntax.Shl:
+		return x << (uint64(y) & 63), nil
+	default: // syntax.Comma
+		// x is executed but its result discarded
+		return y, nil
+	}
+}
+
+// `lvalue` extracts the variable name from the operand of `++`, `--` or `=`.
+// It is either a plain name or an array element e.g. `a[i+1]`.
+func (e *evaluator) lvalue(expr syntax.ArithmExpr) (string, bool) {
+	word, ok := expr.(*syntax.Word)
+	if !ok {
+		return "", false
+	}
+	// the parser represents `a[i+1]` as a short `ParamExp` with an index
+	if len(word.Parts) == 1 {
+		if pe, ok := word.Parts[0].(*syntax.ParamExp); ok && pe.Index != nil && pe.Exp == nil && pe.Repl == nil && pe.Slice == nil {
+			index := e.source(pe.Index.Pos(), pe.Index.End())
+			return pe.Param.Value + "[" + index + "]", index != "" && syntax.ValidName(pe.Param.Value)
+		}
+	}
+	name := word.Lit()
+	return name, syntax.ValidName(name)
+}
+
+// `varValue` reads a variable as an integer, evaluating its value as an
+// arithmetic expression, as bash does for `x="1+2"; echo $((x))`.
+func (e *evaluator) varValue(node syntax.Node, name string) (int64, error) {
+	str, err := e.getVar(node, name)
+	if err != nil {
+		return 0, err
+	}
+	return e.arithmString(node, str)
+}
+
+func (e *evaluator) getVar(node syntax.Node, name string) (string, error) {
+	base, index, isElem := splitArrayRef(name)
+	if !isElem {
+		_, vr := e.host.Env.Get(name).Resolve(e.host.Env)
+		return vr.String(), nil
+	}
+	_, vr := e.host.Env.Get(base).Resolve(e.host.Env)
+	switch vr.Kind {
+	case expand.Associative:
+		key, err := e.subscript(index)
+		if err != nil {
+			return "", err
+		}
+		return vr.Map[key], nil
+	default:
+		i, err := e.index(node, index)
+		if err != nil {
+			return "", err
+		}
+		if vr.Kind == expand.Indexed {
+			if i < 0 {
+				i += int64(len(vr.List))
+			}
+			if i >= 0 && i < int64(len(vr.List)) {
+				return vr.List[i], nil
+			}
+			return "", nil
+		}
+		if i == 0 {
+			return vr.Str, nil
+		}
+		return "", nil
+	}
+}
+
+func (e *evaluator) setVar(node syntax.Node, name string, value int64) error {
+	str := strconv.FormatInt(value, 10)
+	base, index, isElem := splitArrayRef(name)
+	if !isElem {
+		ref, vr := e.host.Env.Get(name).Resolve(e.host.Env)
+		if ref != "" {
+			name = ref
+		}
+		if vr.Kind == expand.Indexed && len(vr.List) > 0 {
+			list := append([]string{str}, vr.List[1:]...)
+			return e.host.Env.Set(name, expand.Variable{Set: true, Kind: expand.Indexed, List: list})
+		}
+		vr.Set, vr.Kind, vr.Str = true, expand.String, str
+		return e.host.Env.Set(name, vr)
+	}
+
+	_, vr := e.host.Env.Get(base).Resolve(e.host.Env)
+	if vr.Kind == expand.Associative {
+		key, err := e.subscript(index)
+		if err != nil {
+			return err
+		}
+		m := make(map[string]string, len(vr.Map)+1)
+		for k, v := range vr.Map {
+			m[k] = v
+		}
+		m[key] = str
+		return e.host.Env.Set(base, expand.Variable{Set: true, Kind: expand.Associative, Map: m})
+	}
+	i, err := e.index(node, index)
+	if err != nil {
+		return err
+	}
+	list := vr.List
+	if vr.Kind == expand.String {
+		list = []string{vr.Str}
+	}
+	if i < 0 {
+		i += int64(len(list))
+	}
+	if i < 0 {
+		return e.errorAt(node, "BadSubscript", "%s: bad array subscript", base)
+	}
+	list = append([]string(nil), list...)
+	for int64(len(list)) <= i {
+		list = append(list, "")
+	}
+	list[i] = str
+	return e.host.Env.Set(base, expand.Variable{Set: true, Kind: expand.Indexed, List: list})
+}
+
+func (e *evaluator) index(node syntax.Node, index string) (int64, error) {
+	expr, err := syntax.NewParser().Arithmetic(strings.NewReader(index))
+	if err != nil {
+		return 0, e.errorAt(node, "BadSubscript", "bad array subscript: %s", index)
+	}
+	return e.arithm(expr)
+}
+
+func (e *evaluator) subscript(index string) (string, error) {
+	word, err := syntax.NewParser().Document(strings.NewReader(index))
+	if err != nil {
+		return index, nil
+	}
+	return expand.Literal(e.cfg, word)
+}
+
+// `splitArrayRef` splits e.g. `a[i+1]` into `a` and `i+1`.
+func splitArrayRef(name string) (string, string, bool) {
+	open := strings.IndexByte(name, '[')
+	if open <= 0 || !strings.HasSuffix(name, "]") {
+		return "", "", false
+	}
+	return name[:open], name[open+1 : len(name)-1], true
+}
+
+// `arithmValue` interprets an expanded word: a variable name, an array element, or a number.
+func (e *evaluator) arithmValue(node syntax.Node, str string) (int64, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return 0, nil
+	}
+	if base, _, ok := splitArrayRef(str); ok && syntax.ValidName(base) {
+		return e.varValue(node, str)
+	}
+	if syntax.ValidName(str) {
+		return e.varValue(node, str)
+	}
+	return e.parseNumber(node, str)
+}
+
+// `arithmString` evaluates a variable's value, which may itself be an expression.
+func (e *evaluator) arithmString(node syntax.Node, str string) (int64, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return 0, nil
+	}
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return n, nil
+	}
+	if e.depth > maxArithmDepth/2 {
+		return 0, e.errorAt(node, "Recursion", "%s: expression recursion level exceeded", str)
+	}
+	expr, err := syntax.NewParser().Arithmetic(strings.NewReader(str))
+	if err != nil {
+		return 0, e.errorAt(node, "Syntax", "%s: syntax error in expression", str)
+	}
+	return e.arithm(expr)
+}
+
+// `parseNumber` follows bash: `0x1f`, `017` (octal), `base#digits` with 2 <= base <= 64.
+// For bases up to 36 letters are case-insensitive, otherwise digits are
+// 0-9, a-z, A-Z, @ and _ in that order.
+func (e *evaluator) parseNumber(node syntax.Node, str string) (int64, error) {
+	base := int64(10)
+	digits := str
+	switch {
+	case strings.Contains(str, "#"):
+		hash := strings.IndexByte(str, '#')
+		b, err := strconv.ParseInt(str[:hash], 10, 64)
+		if err != nil || b < 2 || b > 64 {
+			return 0, e.errorAt(node, "BadBase", "%s: invalid arithmetic base (error token is %q)", str, str)
+		}
+		base, digits = b, str[hash+1:]
+		if digits == "" {
+			return 0, e.errorAt(node, "BadBase", "%s: invalid integer constant (error token is %q)", str, str)
+		}
+	case len(str) > 2 && (str[:2] == "0x" || str[:2] == "0X"):
+		base, digits = 16, str[2:]
+	case len(str) > 1 && str[0] == '0':
+		base, digits = 8, str[1:]
+	}
+
+	var value int64
+	for _, r := range digits {
+		d := digitValue(r, base)
+		if d < 0 {
+			if base == 10 && !isAlnum(r) {
+				return 0, e.errorAt(node, "InvalidNumber", "%s: syntax error: invalid arithmetic operator (error token is %q)", str, digits[strings.IndexRune(digits, r):])
+			}
+			return 0, e.errorAt(node, "ValueTooGreatForBase", "%s: value too great for base (error token is %q)", str, str)
+		}
+		value = value*base + d
+	}
+	return value, nil
+}
+
+func digitValue(r rune, base int64) int64 {
+	var d int64
+	switch {
+	case '0' <= r && r <= '9':
+		d = int64(r - '0')
+	case 'a' <= r && r <= 'z':
+		d = int64(r-'a') + 10
+	case 'A' <= r && r <= 'Z':
+		d = int64(r-'A') + 10
+		if base > 36 {
+			d += 26
+		}
+	case r == '@':
+		d = 62
+	case r == '_':
+		d = 63
+	default:
+		return -1
+	}
+	if d >= base {
+		return -1
+	}
+	return d
+}
+
+func isAlnum(r rune) bool {
+	return '0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || r == '@' || r == '_'
+}
+
+func intPow(a, b int64) int64 {
+	p := int64(1)
+	for b > 0 {
+		if b&1 != 0 {
+			p *= a
+		}
+		b >>= 1
+		a *= a
+	}
+	return p
+}
+
+func oneIf(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (e *evaluator) test(expr syntax.TestExpr) (bool, error) {
+	switch expr := expr.(type) {
+	case *syntax.Word:
+		str, err := expand.Literal(e.cfg, expr)
+		return str != "", err
+
+	case *syntax.ParenTest:
+		return e.test(expr.X)
+
+	case *syntax.UnaryTest:
+		if expr.Op == syntax.TsNot {
+			ok, err := e.test(expr.X)
+			return !ok, err
+		}
+		str, err := expand.Literal(e.cfg, expr.X.(*syntax.Word))
+		if err != nil {
+			return false, err
+		}
+		switch expr.Op {
+		case syntax.TsEmpStr:
+			return str == "", nil
+		case syntax.TsNempStr:
+			return str != "", nil
+		case syntax.TsVarSet:
+			name, index, isElem := splitArrayRef(str)
+			if !isElem {
+				return e.host.Env.Get(str).IsSet(), nil
+			}
+			vr := e.host.Env.Get(name)
+			if vr.Kind == expand.Associative {
+				_, ok := vr.Map[index]
+				return ok, nil
+			}
+			i, err := e.index(expr, index)
+			if err != nil {
+				return false, err
+			}
+			return vr.IsSet() && i >= 0 && i < int64(len(vr.List)), nil
+		case syntax.TsRefVar:
+			return e.host.Env.Get(str).Kind == expand.NameRef, nil
+		case syntax.TsOptSet:
+			if e.host.OptionSet == nil {
+				return false, nil
+			}
+			return e.host.OptionSet(str), nil
+		default:
+			return e.fileTest(expr, expr.Op.String(), str, "")
+		}
+
+	case *syntax.BinaryTest:
+		switch expr.Op {
+		case syntax.AndTest, syntax.OrTest:
+			left, err := e.test(expr.X)
+			if err != nil {
+				return false, err
+			}
+			if (expr.Op == syntax.AndTest) != left {
+				return left, nil
+			}
+			return e.test(expr.Y)
+		}
+
+		left, err := expand.Literal(e.cfg, expr.X.(*syntax.Word))
+		if err != nil {
+			return false, err
+		}
+		rightWord := expr.Y.(*syntax.Word)
+
+		switch expr.Op {
+		case syntax.TsMatchShort, syntax.TsMatch, syntax.TsNoMatch:
+			extGlobToLit(rightWord)
+			pat, err := expand.Pattern(e.cfg, rightWord)
+			if err != nil {
+				return false, err
+			}
+			// bash always enables extglob within [[ … ]]
+			match, err := compileGlob(pat, pattern.EntireString|ExtGlob)
+			if err != nil {
+				return false, e.errorAt(rightWord, "BadPattern", "%s", err.Error())
+			}
+			return match(left) == (expr.Op != syntax.TsNoMatch), nil
+		case syntax.TsReMatch:
+			right, err := expand.Literal(e.cfg, rightWord)
+			if err != nil {
+				return false, err
+			}
+			return e.reMatch(rightWord, left, right)
+		}
+
+		right, err := expand.Literal(e.cfg, rightWord)
+		if err != nil {
+			return false, err
+		}
+		switch expr.Op {
+		case syntax.TsBefore:
+			return left < right, nil
+		case syntax.TsAfter:
+			return left > right, nil
+		case syntax.TsEql, syntax.TsNeq, syntax.TsLeq, syntax.TsGeq, syntax.TsLss, syntax.TsGtr:
+			x, err := e.arithmString(expr.X, left)
+			if err != nil {
+				return false, err
+			}
+			y, err := e.arithmString(expr.Y, right)
+			if err != nil {
+				return false, err
+			}
+			switch expr.Op {
+			case syntax.TsEql:
+				return x == y, nil
+			case syntax.TsNeq:
+				return x != y, nil
+			case syntax.TsLeq:
+				return x <= y, nil
+			case syntax.TsGeq:
+				return x >= y, nil
+			case syntax.TsLss:
+				return x < y, nil
+			default: // syntax.TsGtr
+				return x > y, nil
+			}
+		default: // -nt, -ot, -ef
+			return e.fileTest(expr, expr.Op.String(), left, right)
+		}
+	}
+	return false, e.errorAt(expr, "Syntax", "unexpected test expression %T", expr)
+}
+
+// `reMatch` implements `=~`, setting BASH_REMATCH like bash.
+func (e *evaluator) reMatch(node syntax.Node, left, right string) (bool, error) {
+	rx, err := regexp.Compile(right)
+	if err != nil {
+		return false, e.errorAt(node, "BadRegexp", "%s", err.Error())
+	}
+	match := rx.FindStringSubmatch(left)
+	if match == nil {
+		return false, nil
+	}
+	if err := e.host.Env.Set("BASH_REMATCH", expand.Variable{Set: true, Kind: expand.Indexed, List: match}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (e *evaluator) fileTest(node syntax.Node, op, x, y string) (bool, error) {
+	if e.host.FileTest == nil {
+		return false, e.errorAt(node, "Unsupported", "%s: file tests are not available", op)
+	}
+	ok, err := e.host.FileTest(op, x, y)
+	if err != nil {
+		return false, e.errorAt(node, "Host", "%s", err.Error())
+	}
+	return ok, nil
+}
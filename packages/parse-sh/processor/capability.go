@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"slices"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `structuralNodes` are always supported, since every script has them.
+var structuralNodes = []string{"File", "Stmt", "Word", "Lit"}
+
+// `nodeDescriptions` describe node types in messages, where others use their type.
+var nodeDescriptions = map[string]string{
+	"ArithmCmd":    "`(( ))`",
+	"ArithmExp":    "`$(( ))`",
+	"ArrayExpr":    "array",
+	"CStyleLoop":   "`for (( ))`",
+	"CmdSubst":     "command substitution",
+	"CoprocClause": "`coproc`",
+	"ExtGlob":      "extended glob",
+	"LetClause":    "`let`",
+	"ProcSubst":    "process substitution",
+	"TestClause":   "`[[ ]]`",
+	"TestDecl":     "`@test`",
+}
+
+// `CheckCapabilities` reports every node of `file` which a runtime cannot execute, given the node
+// types and operators it supports, so a script can be rejected before it has side effects.
+//   - node types are e.g. `CallExpr` or `ProcSubst`, where `File`, `Stmt`, `Word` and `Lit` are implied
+//   - operators are e.g. `&&` or `BinaryCmd:|&` for a specific node type, including flags
+//     e.g. `ForClause:select`, `TimeClause:-p`, `Stmt:&` or `ParamExp:#`, see `nodeOperators`
+//
+// An empty list of node types or operators is not checked.
+func CheckCapabilities(file *syntax.File, capabilities Capabilities) []Diagnostic {
+	diags := []Diagnostic{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			return true
+		}
+		typ := nodeTypeName(node)
+		if len(capabilities.Nodes) > 0 && !slices.Contains(structuralNodes, typ) && !slices.Contains(capabilities.Nodes, typ) {
+			description := typ
+			if d, ok := nodeDescriptions[typ]; ok {
+				description += " (" + d + ")"
+			}
+			diags = append(diags, capabilityDiagnostic("unsupported-node", node, description+" is not supported"))
+			return true
+		}
+		if len(capabilities.Operators) == 0 {
+			return true
+		}
+		for _, op := range nodeOperators(node) {
+			if !slices.Contains(capabilities.Operators, op) && !slices.Contains(capabilities.Operators, typ+":"+op) {
+				diags = append(diags, capabilityDiagnostic("unsupported-operator", node, "Operator `"+op+"` of "+typ+" is not supported"))
+			}
+		}
+		return true
+	})
+
+	slices.SortStableFunc(diags, func(a, b Diagnostic) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	return diags
+}
+
+// `nodeOperators` are the operators of a node, as named in the mapped `Op` fields,
+// along with flags changing its behaviour.
+func nodeOperators(node syntax.Node) []string {
+	switch node := node.(type) {
+	case *syntax.Stmt:
+		ops := []string{}
+		if node.Negated {
+			ops = append(ops, "!")
+		}
+		if node.Background {
+			ops = append(ops, "&")
+		}
+		if node.Coprocess {
+			ops = append(ops, "|&")
+		}
+		return ops
+	case *syntax.BinaryCmd:
+		return []string{node.Op.String()}
+	case *syntax.Redirect:
+		return []string{node.Op.String()}
+	case *syntax.CaseItem:
+		return []string{node.Op.String()}
+	case *syntax.BinaryArithm:
+		return []string{node.Op.String()}
+	case *syntax.UnaryArithm:
+		return []string{node.Op.String()}
+	case *syntax.BinaryTest:
+		return []string{node.Op.String()}
+	case *syntax.UnaryTest:
+		return []string{node.Op.String()}
+	case *syntax.ExtGlob:
+		return []string{node.Op.String()}
+	case *syntax.ProcSubst:
+		return []string{node.Op.String()}
+	case *syntax.DeclClause:
+		// e.g. `local` or `declare`
+		return []string{node.Variant.Value}
+	case *syntax.ForClause:
+		if node.Select {
+			return []string{"select"}
+		}
+	case *syntax.TimeClause:
+		if node.PosixFormat {
+			return []string{"-p"}
+		}
+	case *syntax.SglQuoted:
+		if node.Dollar {
+			return []string{"$"}
+		}
+	case *syntax.DblQuoted:
+		if node.Dollar {
+			return []string{"$"}
+		}
+	case *syntax.ParamExp:
+		ops := []string{}
+		if node.Length {
+			ops = append(ops, "#")
+		}
+		if node.Width {
+			ops = append(ops, "%")
+		}
+		if node.Excl {
+			ops = append(ops, "!")
+		}
+		if node.Index != nil {
+			ops = append(ops, "[]")
+		}
+		if node.Slice != nil {
+			ops = append(ops, ":")
+		}
+		if node.Repl != nil {
+			if node.Repl.All {
+				ops = append(ops, "//")
+			} else {
+				ops = append(ops, "/")
+			}
+		}
+		if node.Names != 0 {
+			ops = append(ops, "!"+node.Names.String())
+		}
+		if node.Exp != nil {
+			ops = append(ops, node.Exp.Op.String())
+		}
+		return ops
+	}
+	return nil
+}
+
+func capabilityDiagnostic(rule string, node syntax.Node, message string) Diagnostic {
+	return Diagnostic{
+		Rule:     rule,
+		Severity: SeverityError,
+		Message:  message,
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+	}
+}
@@ -0,0 +1,36 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckCapabilities(t *testing.T) {
+	tests := []struct {
+		text         string
+		capabilities Capabilities
+		// rule and message of each diagnostic
+		want []string
+	}{
+		{"echo hi", Capabilities{Nodes: []string{"CallExpr"}}, []string{}},
+		{"cat <(ls)", Capabilities{Nodes: []string{"CallExpr"}}, []string{"unsupported-node: ProcSubst (process substitution) is not supported"}},
+		{"a && b", Capabilities{Operators: []string{"||"}}, []string{"unsupported-operator: Operator `&&` of BinaryCmd is not supported"}},
+		{"a && b", Capabilities{Operators: []string{"BinaryCmd:&&"}}, []string{}},
+		{"a && b", Capabilities{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, diag := range CheckCapabilities(file, tt.capabilities) {
+				got = append(got, diag.Rule+": "+diag.Message)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
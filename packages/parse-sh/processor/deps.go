@@ -0,0 +1,276 @@
+package processor
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `DepsFile` is a parsed file to be included in a dependency graph.
+type DepsFile struct {
+	Path string
+	File *syntax.File
+}
+
+// `Deps` extracts static references between `files`, i.e. `source`, `import`, `run`/`map`
+// module-function pairs and calls of functions defined by some file.
+// References which cannot be resolved statically are marked `Dynamic` e.g. `source $f` or `$cmd`.
+//
+// Like bash, a relative `source` target is resolved against the current directory `cwd`, rather
+// than the sourcing file's directory. It is `Dynamic` if `cwd` is "", and a name without `/`
+// is not first looked up in `$PATH`.
+//
+// `LoadOrder` lists sourced files before the files sourcing them.
+func Deps(files []DepsFile, modules map[string][]string, cwd string) DepsGraph {
+	graph := DepsGraph{
+		Functions: []DepsFunction{},
+		Refs:      []DepsRef{},
+		LoadOrder: []string{},
+	}
+
+	// functions defined in files or imported from modules, by name
+	defined := map[string][]int{}
+	for _, file := range files {
+		syntax.Walk(file.File, func(node syntax.Node) bool {
+			switch node := node.(type) {
+			case *syntax.FuncDecl:
+				graph.Functions = append(graph.Functions, DepsFunction{
+					Name: node.Name.Value,
+					File: file.Path,
+					Pos:  mapPos(node.Name.Pos()),
+					End:  mapPos(node.Name.End()),
+				})
+			case *syntax.CallExpr:
+				for _, ref := range moduleRefs(modules, node) {
+					if len(node.Args) == 0 || node.Args[0].Lit() != "import" {
+						continue
+					}
+					fns := ref.fns
+					if len(fns) == 0 {
+						for _, fn := range modules[ref.module.Lit()] {
+							graph.Functions = append(graph.Functions, DepsFunction{
+								Name:   fn,
+								File:   file.Path,
+								Module: ref.module.Lit(),
+								Pos:    mapPos(ref.module.Pos()),
+								End:    mapPos(ref.module.End()),
+							})
+						}
+					}
+					for _, word := range fns {
+						fn, alias, ok := strings.Cut(word.Lit(), ":")
+						if !ok {
+							alias = fn
+						}
+						graph.Functions = append(graph.Functions, DepsFunction{
+							Name:   alias,
+							File:   file.Path,
+							Module: ref.module.Lit(),
+							Pos:    mapPos(word.Pos()),
+							End:    mapPos(word.End()),
+						})
+					}
+				}
+			}
+			return true
+		})
+	}
+	for i, fn := range graph.Functions {
+		defined[fn.Name] = append(defined[fn.Name], i)
+	}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = file.Path
+	}
+
+	for _, file := range files {
+		d := &depsWalker{graph: &graph, file: file.Path, cwd: cwd, paths: paths, modules: modules, defined: defined}
+		syntax.Walk(file.File, d.visit)
+	}
+
+	graph.LoadOrder = loadOrder(paths, graph.Refs)
+	return graph
+}
+
+type depsWalker struct {
+	graph   *DepsGraph
+	file    string
+	cwd     string
+	paths   []string
+	modules map[string][]string
+	defined map[string][]int
+	stack   []syntax.Node
+}
+
+func (d *depsWalker) visit(node syntax.Node) bool {
+	if node == nil {
+		d.stack = d.stack[:len(d.stack)-1]
+		return true
+	}
+	d.stack = append(d.stack, node)
+
+	call, ok := node.(*syntax.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return true
+	}
+
+	name, static := staticString(call.Args[0])
+	switch {
+	case !static:
+		d.add(DepsRef{Kind: "call", Dynamic: true}, call.Args[0])
+	case name == "source" || name == ".":
+		d.source(call)
+	case name == "eval":
+		d.add(DepsRef{Kind: "eval", Dynamic: true}, call)
+	case name == "run" || name == "map" || name == "import":
+		d.module(call, name)
+	}
+
+	if indices, ok := d.defined[name]; ok && static {
+		fn := d.resolve(indices)
+		d.graph.Functions[fn].Callers++
+		d.add(DepsRef{
+			Kind:      "call",
+			Target:    name,
+			DefinedIn: d.graph.Functions[fn].File,
+			Module:    d.graph.Functions[fn].Module,
+		}, call.Args[0])
+	}
+	return true
+}
+
+// `resolve` prefers a function defined in the current file, else the first defined.
+func (d *depsWalker) resolve(indices []int) int {
+	for _, i := range indices {
+		if d.graph.Functions[i].File == d.file {
+			return i
+		}
+	}
+	return indices[0]
+}
+
+// `add` records a reference from the current file and enclosing function.
+func (d *depsWalker) add(ref DepsRef, node syntax.Node) {
+	ref.From = d.file
+	for i := len(d.stack) - 1; i >= 0; i-- {
+		if fn, ok := d.stack[i].(*syntax.FuncDecl); ok {
+			ref.Function = fn.Name.Value
+			break
+		}
+	}
+	ref.Pos, ref.End = mapPos(node.Pos()), mapPos(node.End())
+	d.graph.Refs = append(d.graph.Refs, ref)
+}
+
+func (d *depsWalker) source(call *syntax.CallExpr) {
+	if len(call.Args) < 2 {
+		return
+	}
+	arg := call.Args[1]
+	target, ok := staticString(arg)
+	if !ok {
+		d.add(DepsRef{Kind: "source", Dynamic: true}, arg)
+		return
+	}
+	if !path.IsAbs(target) {
+		if d.cwd == "" {
+			d.add(DepsRef{Kind: "source", Target: target, Dynamic: true}, arg)
+			return
+		}
+		target = path.Join(d.cwd, target)
+	}
+	ref := DepsRef{Kind: "source", Target: target}
+	if slices.Contains(d.paths, target) {
+		ref.DefinedIn = target
+	}
+	d.add(ref, arg)
+}
+
+func (d *depsWalker) module(call *syntax.CallExpr, kind string) {
+	refs := moduleRefs(d.modules, call)
+	if len(refs) == 0 {
+		if len(call.Args) > 1 && !isStaticWord(call.Args[1]) && kind != "import" {
+			// e.g. `run $mod fn`, whereas a quoted operand is JavaScript
+			d.add(DepsRef{Kind: kind, Dynamic: true}, call.Args[1])
+		}
+		return
+	}
+	for _, ref := range refs {
+		module := ref.module.Lit()
+		if len(ref.fns) == 0 {
+			d.add(DepsRef{Kind: kind, Target: module, Module: module}, ref.module)
+			continue
+		}
+		for _, word := range ref.fns {
+			fn, _, _ := strings.Cut(word.Lit(), ":")
+			if fn == "" {
+				d.add(DepsRef{Kind: kind, Module: module, Dynamic: true}, word)
+				continue
+			}
+			d.add(DepsRef{Kind: kind, Target: module + "." + fn, Module: module}, word)
+		}
+	}
+}
+
+// `staticString` returns the value of a word without expansions e.g. `/etc/util.sh` or `"a b"`.
+func staticString(word *syntax.Word) (string, bool) {
+	var sb strings.Builder
+	for _, part := range word.Parts {
+		switch part := part.(type) {
+		case *syntax.Lit:
+			if hasGlobMeta(part.Value, false) {
+				return "", false
+			}
+			sb.WriteString(unescapePattern(part.Value))
+		case *syntax.SglQuoted:
+			if part.Dollar {
+				return "", false
+			}
+			sb.WriteString(part.Value)
+		case *syntax.DblQuoted:
+			for _, part := range part.Parts {
+				lit, ok := part.(*syntax.Lit)
+				if !ok {
+					return "", false
+				}
+				sb.WriteString(lit.Value)
+			}
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+// `loadOrder` topologically sorts `paths` so each sourced file precedes its sourcers,
+// keeping the given order otherwise. Files in a cycle keep their given order.
+func loadOrder(paths []string, refs []DepsRef) []string {
+	sources := map[string][]string{}
+	for _, ref := range refs {
+		if ref.Kind == "source" && ref.DefinedIn != "" {
+			sources[ref.From] = append(sources[ref.From], ref.DefinedIn)
+		}
+	}
+
+	order := []string{}
+	state := map[string]int{} // 1 visiting, 2 done
+	var visit func(p string)
+	visit = func(p string) {
+		if state[p] != 0 {
+			return
+		}
+		state[p] = 1
+		for _, dep := range sources[p] {
+			visit(dep)
+		}
+		state[p] = 2
+		order = append(order, p)
+	}
+	for _, p := range paths {
+		visit(p)
+	}
+	return order
+}
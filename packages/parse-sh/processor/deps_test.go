@@ -0,0 +1,72 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDepsSource(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		cwd  string
+		// target, file defining it, and whether dynamic
+		want DepsRef
+	}{
+		{"absolute", "source /lib/util.sh", "", DepsRef{Target: "/lib/util.sh", DefinedIn: "/lib/util.sh"}},
+		{"relative to cwd", ". lib/util.sh", "/", DepsRef{Target: "/lib/util.sh", DefinedIn: "/lib/util.sh"}},
+		{"not relative to the file", "source util.sh", "/home", DepsRef{Target: "/home/util.sh"}},
+		{"relative without cwd", "source util.sh", "", DepsRef{Target: "util.sh", Dynamic: true}},
+		{"dynamic", "source $f", "/", DepsRef{Dynamic: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main, err := Parse(tt.text, "/lib/main.sh", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			util, _ := Parse("f() { :; }", "/lib/util.sh", ParserOptions{})
+			graph := Deps([]DepsFile{{"/lib/main.sh", main}, {"/lib/util.sh", util}}, nil, tt.cwd)
+			if len(graph.Refs) != 1 {
+				t.Fatalf("refs = %+v, want one", graph.Refs)
+			}
+			ref := graph.Refs[0]
+			if ref.Kind != "source" || ref.Target != tt.want.Target || ref.DefinedIn != tt.want.DefinedIn || ref.Dynamic != tt.want.Dynamic {
+				t.Errorf("ref = %+v, want %+v", ref, tt.want)
+			}
+		})
+	}
+}
+
+func TestDepsCallsAndOrder(t *testing.T) {
+	main, err := Parse("source /util.sh\ng() { f; run core spawn; }", "/main.sh", ParserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	util, _ := Parse("f() { :; }\nh() { :; }", "/util.sh", ParserOptions{})
+	graph := Deps([]DepsFile{{"/main.sh", main}, {"/util.sh", util}}, map[string][]string{"core": {"spawn"}}, "/")
+
+	if want := []string{"/util.sh", "/main.sh"}; !slices.Equal(graph.LoadOrder, want) {
+		t.Errorf("load order = %q, want %q", graph.LoadOrder, want)
+	}
+	type ref struct{ kind, function, target, definedIn string }
+	got := []ref{}
+	for _, r := range graph.Refs {
+		got = append(got, ref{r.Kind, r.Function, r.Target, r.DefinedIn})
+	}
+	want := []ref{
+		{"source", "", "/util.sh", "/util.sh"},
+		{"call", "g", "f", "/util.sh"},
+		{"run", "g", "core.spawn", ""},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("refs = %+v, want %+v", got, want)
+	}
+	callers := map[string]int{}
+	for _, fn := range graph.Functions {
+		callers[fn.Name] = fn.Callers
+	}
+	if callers["f"] != 1 || callers["h"] != 0 {
+		t.Errorf("callers = %v, want f called once and h never", callers)
+	}
+}
@@ -0,0 +1,473 @@
+package processor
+
+import (
+	"bytes"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+type DesugarPass uint
+
+const (
+	// `syntax.Simplify` e.g. `$(( (x) ))`, `(($x))`, `$( (stmts) )`, `[[ ! -n $x ]]`
+	PassSimplify DesugarPass = 1 << iota
+	// `let x=1 y++` to `(( x=1, y++ ))`
+	PassLet
+	// `for ((i=0; i<n; i++)); do …; done` to `i=0 while`-loop, unless the body uses `continue`
+	PassCStyleFor
+	// `time cmd` to `cmd`
+	PassTime
+	// `function f`, `function f()` and `f() cmd` to `f() { …; }`
+	PassFuncDecl
+	// `[ … ]` to `[[ … ]]`, when each operand is a literal or quoted word without globs,
+	// and no integer comparison is made e.g. `-eq`, which `[[` evaluates arithmetically.
+	// Not `test …`, which evaluates JavaScript in our runtime.
+	PassTest
+	// `${x:-}` and `${x-}` to `$x`, which differ under `set -u` where only `$x` fails if unset,
+	// so it is not in `PassAll` and must be requested
+	PassParamExp
+	// `{ cmd; }` to `cmd`
+	PassBlock
+
+	// the passes preserving behaviour
+	PassAll = PassSimplify | PassLet | PassCStyleFor | PassTime | PassFuncDecl | PassTest | PassBlock
+)
+
+var passNames = map[DesugarPass]string{
+	PassSimplify:  "simplify",
+	PassLet:       "let",
+	PassCStyleFor: "cstyle-for",
+	PassTime:      "time",
+	PassFuncDecl:  "func-decl",
+	PassTest:      "test",
+	PassParamExp:  "param-exp",
+	PassBlock:     "block",
+}
+
+type desugarer struct {
+	passes  DesugarPass
+	printer *syntax.Printer
+	changes []Rewrite
+	// function bodies must remain compound commands
+	funcBodies map[*syntax.Stmt]bool
+}
+
+// `Desugar` rewrites `file` in place so an interpreter can target a smaller core language,
+// returning a record of what changed. The passes run in the order they are declared.
+//
+// Synthesized nodes reuse the positions of the nodes they replace, so mapped positions
+// still refer to the original source.
+func Desugar(file *syntax.File, passes DesugarPass) []Rewrite {
+	if passes == 0 {
+		passes = PassAll
+	}
+	d := &desugarer{
+		passes:     passes,
+		printer:    syntax.NewPrinter(syntax.SingleLine(true)),
+		changes:    []Rewrite{},
+		funcBodies: map[*syntax.Stmt]bool{},
+	}
+
+	if passes&PassSimplify != 0 {
+		for _, stmt := range file.Stmts {
+			before := d.print(stmt)
+			if syntax.Simplify(stmt) {
+				d.record(PassSimplify, stmt, before)
+			}
+		}
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.Stmt:
+			d.stmt(node)
+		case *syntax.FuncDecl:
+			d.funcBodies[node.Body] = true
+		case *syntax.Word:
+			if passes&PassParamExp != 0 {
+				d.word(node)
+			}
+		}
+		return true
+	})
+
+	return d.changes
+}
+
+func (d *desugarer) print(node syntax.Node) string {
+	var buf bytes.Buffer
+	if err := d.printer.Print(&buf, node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+func (d *desugarer) record(pass DesugarPass, node syntax.Node, before string) {
+	d.changes = append(d.changes, Rewrite{
+		Pass:   passNames[pass],
+		Pos:    mapPos(node.Pos()),
+		End:    mapPos(node.End()),
+		Before: before,
+		After:  d.print(node),
+	})
+}
+
+// `stmt` repeatedly rewrites a statement's command until no pass applies,
+// e.g. `time let x++` becomes `(( x++ ))`.
+func (d *desugarer) stmt(stmt *syntax.Stmt) {
+	for stmt.Cmd != nil {
+		before := d.print(stmt)
+		pass := d.rewrite(stmt)
+		if pass == 0 {
+			return
+		}
+		d.record(pass, stmt, before)
+	}
+}
+
+func (d *desugarer) rewrite(stmt *syntax.Stmt) DesugarPass {
+	switch cmd := stmt.Cmd.(type) {
+	case *syntax.LetClause:
+		if d.passes&PassLet == 0 || len(cmd.Exprs) == 0 {
+			break
+		}
+		x := cmd.Exprs[0]
+		for _, y := range cmd.Exprs[1:] {
+			x = &syntax.BinaryArithm{OpPos: y.Pos(), Op: syntax.Comma, X: x, Y: y}
+		}
+		stmt.Cmd = &syntax.ArithmCmd{Left: cmd.Let, Right: cmd.End(), X: x}
+		return PassLet
+
+	case *syntax.ForClause:
+		loop, ok := cmd.Loop.(*syntax.CStyleLoop)
+		if d.passes&PassCStyleFor == 0 || !ok || cmd.Select || hasContinue(cmd.Do) {
+			break
+		}
+		stmt.Cmd = desugarCStyleFor(cmd, loop)
+		return PassCStyleFor
+
+	case *syntax.TimeClause:
+		if d.passes&PassTime == 0 || cmd.Stmt == nil || !canMerge(stmt, cmd.Stmt) {
+			break
+		}
+		mergeStmt(stmt, cmd.Stmt)
+		return PassTime
+
+	case *syntax.FuncDecl:
+		if d.passes&PassFuncDecl == 0 {
+			break
+		}
+		_, isBlock := cmd.Body.Cmd.(*syntax.Block)
+		_, isSubshell := cmd.Body.Cmd.(*syntax.Subshell)
+		if !cmd.RsrvWord && (isBlock || isSubshell) {
+			break
+		}
+		cmd.RsrvWord, cmd.Parens = false, false
+		if !isBlock && !isSubshell {
+			cmd.Body = &syntax.Stmt{
+				Position: cmd.Body.Pos(),
+				Cmd: &syntax.Block{
+					Lbrace: cmd.Body.Pos(),
+					Rbrace: cmd.Body.End(),
+					Stmts:  []*syntax.Stmt{cmd.Body},
+				},
+			}
+		}
+		return PassFuncDecl
+
+	case *syntax.CallExpr:
+		if d.passes&PassTest == 0 || len(cmd.Assigns) > 0 || len(cmd.Args) == 0 {
+			break
+		}
+		if clause := classicTestClause(cmd); clause != nil {
+			stmt.Cmd = clause
+			return PassTest
+		}
+
+	case *syntax.Block:
+		if d.passes&PassBlock == 0 || len(cmd.Stmts) != 1 || len(cmd.Last) > 0 || d.funcBodies[stmt] || !canMerge(stmt, cmd.Stmts[0]) {
+			break
+		}
+		mergeStmt(stmt, cmd.Stmts[0])
+		return PassBlock
+	}
+	return 0
+}
+
+// `canMerge` reports whether `outer` can be replaced by `inner` keeping the outer
+// statement's flags, where outer redirects apply before inner ones.
+func canMerge(outer, inner *syntax.Stmt) bool {
+	return !inner.Background && !inner.Coprocess && !outer.Coprocess &&
+		!(outer.Negated && inner.Negated) && inner.Cmd != nil
+}
+
+func mergeStmt(outer, inner *syntax.Stmt) {
+	outer.Cmd = inner.Cmd
+	outer.Negated = outer.Negated || inner.Negated
+	outer.Redirs = append(outer.Redirs, inner.Redirs...)
+	outer.Comments = append(outer.Comments, inner.Comments...)
+}
+
+// `hasContinue` is conservative: a `continue` anywhere would skip the loop's post-expression.
+func hasContinue(stmts []*syntax.Stmt) bool {
+	found := false
+	for _, stmt := range stmts {
+		syntax.Walk(stmt, func(node syntax.Node) bool {
+			if call, ok := node.(*syntax.CallExpr); ok && len(call.Args) > 0 && call.Args[0].Lit() == "continue" {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// `desugarCStyleFor` produces `{ (( init )); while (( cond )); do …; (( post )); done; }`.
+func desugarCStyleFor(cmd *syntax.ForClause, loop *syntax.CStyleLoop) syntax.Command {
+	arithmStmt := func(x syntax.ArithmExpr) *syntax.Stmt {
+		return &syntax.Stmt{
+			Position: x.Pos(),
+			Cmd:      &syntax.ArithmCmd{Left: x.Pos(), Right: x.End(), X: x},
+		}
+	}
+
+	cond := loop.Cond
+	if cond == nil {
+		cond = &syntax.Word{Parts: []syntax.WordPart{&syntax.Lit{ValuePos: loop.Lparen, ValueEnd: loop.Rparen, Value: "1"}}}
+	}
+	body := append([]*syntax.Stmt{}, cmd.Do...)
+	if loop.Post != nil {
+		body = append(body, arithmStmt(loop.Post))
+	}
+	while := &syntax.WhileClause{
+		WhilePos: cmd.ForPos,
+		DoPos:    cmd.DoPos,
+		DonePos:  cmd.DonePos,
+		Cond:     []*syntax.Stmt{arithmStmt(cond)},
+		Do:       body,
+		DoLast:   cmd.DoLast,
+	}
+	if loop.Init == nil {
+		return while
+	}
+	return &syntax.Block{
+		Lbrace: cmd.ForPos,
+		Rbrace: cmd.DonePos,
+		Stmts: []*syntax.Stmt{
+			arithmStmt(loop.Init),
+			{Position: cmd.ForPos, Cmd: while},
+		},
+	}
+}
+
+// `word` rewrites `${x:-}` and `${x-}` as `$x`.
+func (d *desugarer) word(word *syntax.Word) {
+	for _, part := range word.Parts {
+		pe, ok := part.(*syntax.ParamExp)
+		if !ok || pe.Exp == nil || pe.Exp.Word != nil && len(pe.Exp.Word.Parts) > 0 {
+			continue
+		}
+		if pe.Exp.Op != syntax.DefaultUnsetOrNull && pe.Exp.Op != syntax.DefaultUnset {
+			continue
+		}
+		if pe.Excl || pe.Length || pe.Width || pe.Index != nil || pe.Slice != nil || pe.Repl != nil || pe.Names != 0 {
+			continue
+		}
+		before := d.print(word)
+		pe.Exp = nil
+		pe.Short = isShortParam(word, pe)
+		d.record(PassParamExp, word, before)
+	}
+}
+
+// `isShortParam` holds if `$name` can replace `${name}` e.g. not `${10}` nor `${x}y`.
+func isShortParam(word *syntax.Word, pe *syntax.ParamExp) bool {
+	if name := pe.Param.Value; len(name) > 1 && !syntax.ValidName(name) {
+		return false
+	}
+	for i, part := range word.Parts[:len(word.Parts)-1] {
+		if part != pe {
+			continue
+		}
+		lit, ok := word.Parts[i+1].(*syntax.Lit)
+		if !ok || lit.Value == "" {
+			return true
+		}
+		c := lit.Value[0]
+		return !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9')
+	}
+	return true
+}
+
+var (
+	unaryTestOps  = map[string]syntax.UnTestOperator{"-h": syntax.TsSmbLink}
+	binaryTestOps = map[string]syntax.BinTestOperator{
+		"=":  syntax.TsMatchShort,
+		"==": syntax.TsMatch,
+		"!=": syntax.TsNoMatch,
+		"<":  syntax.TsBefore,
+		">":  syntax.TsAfter,
+	}
+)
+
+func init() {
+	for op := syntax.TsExists; op <= syntax.TsRefVar; op++ {
+		unaryTestOps[op.String()] = op
+	}
+	// not `-eq` through `-gt`, whose operands are arithmetic within `[[`
+	for op := syntax.TsNewer; op < syntax.TsEql; op++ {
+		binaryTestOps[op.String()] = op
+	}
+}
+
+// `classicTestClause` converts `[ … ]` to `[[ … ]]`, or returns nil if the meaning could change:
+// unquoted expansions are split and globbed by `[`, but not by `[[`, and an unquoted operand
+// like `-n` would be parsed by `[[` as an operator.
+func classicTestClause(call *syntax.CallExpr) *syntax.TestClause {
+	args := call.Args[1:]
+	if call.Args[0].Lit() != "[" || len(args) == 0 || args[len(args)-1].Lit() != "]" {
+		return nil
+	}
+	args = args[:len(args)-1]
+	if len(args) == 0 {
+		return nil
+	}
+	for _, arg := range args {
+		if !isStaticWord(arg) {
+			return nil
+		}
+	}
+
+	p := &classicTestParser{args: args}
+	expr := p.or()
+	if expr == nil || p.i != len(args) {
+		return nil
+	}
+	return &syntax.TestClause{Left: call.Pos(), Right: call.End(), X: expr}
+}
+
+// `isStaticWord` holds for a single literal without glob characters, or a single quoted word.
+func isStaticWord(word *syntax.Word) bool {
+	if len(word.Parts) != 1 {
+		return false
+	}
+	switch part := word.Parts[0].(type) {
+	case *syntax.Lit:
+		return !hasGlobMeta(part.Value, false)
+	case *syntax.SglQuoted, *syntax.DblQuoted:
+		return true
+	}
+	return false
+}
+
+// `isTestOperator` holds for literals `[[` would not parse as an operand.
+func isTestOperator(word *syntax.Word) bool {
+	lit := word.Lit()
+	if _, ok := unaryTestOps[lit]; ok {
+		return true
+	}
+	switch lit {
+	case "!", "(", ")", "-a", "-o", "-eq", "-ne", "-lt", "-le", "-gt", "-ge":
+		return true
+	}
+	_, ok := binaryTestOps[lit]
+	return ok
+}
+
+// `classicTestParser` parses the arguments of `[` with precedence `-o` < `-a` < `!`.
+type classicTestParser struct {
+	args []*syntax.Word
+	i    int
+}
+
+func (p *classicTestParser) peek(offset int) string {
+	if p.i+offset >= len(p.args) {
+		return ""
+	}
+	if lit := p.args[p.i+offset].Lit(); lit != "" {
+		return lit
+	}
+	return "\x00" // quoted, so never an operator
+}
+
+func (p *classicTestParser) or() syntax.TestExpr {
+	x := p.and()
+	for x != nil && p.peek(0) == "-o" {
+		opPos := p.args[p.i].Pos()
+		p.i++
+		y := p.and()
+		if y == nil {
+			return nil
+		}
+		x = &syntax.BinaryTest{OpPos: opPos, Op: syntax.OrTest, X: x, Y: y}
+	}
+	return x
+}
+
+func (p *classicTestParser) and() syntax.TestExpr {
+	x := p.not()
+	for x != nil && p.peek(0) == "-a" {
+		opPos := p.args[p.i].Pos()
+		p.i++
+		y := p.not()
+		if y == nil {
+			return nil
+		}
+		x = &syntax.BinaryTest{OpPos: opPos, Op: syntax.AndTest, X: x, Y: y}
+	}
+	return x
+}
+
+func (p *classicTestParser) not() syntax.TestExpr {
+	if p.peek(0) == "!" && p.peek(1) != "" {
+		opPos := p.args[p.i].Pos()
+		p.i++
+		x := p.not()
+		if x == nil {
+			return nil
+		}
+		return &syntax.UnaryTest{OpPos: opPos, Op: syntax.TsNot, X: x}
+	}
+	return p.primary()
+}
+
+func (p *classicTestParser) primary() syntax.TestExpr {
+	switch first := p.peek(0); {
+	case first == "":
+		return nil
+	case first == "(":
+		lparen := p.args[p.i].Pos()
+		p.i++
+		x := p.or()
+		if x == nil || p.peek(0) != ")" {
+			return nil
+		}
+		rparen := p.args[p.i].Pos()
+		p.i++
+		return &syntax.ParenTest{Lparen: lparen, Rparen: rparen, X: x}
+	}
+
+	if op, ok := binaryTestOps[p.peek(1)]; ok && p.peek(2) != "" {
+		x, opPos, y := p.args[p.i], p.args[p.i+1].Pos(), p.args[p.i+2]
+		if isTestOperator(x) || isTestOperator(y) {
+			return nil
+		}
+		p.i += 3
+		return &syntax.BinaryTest{OpPos: opPos, Op: op, X: x, Y: y}
+	}
+	if op, ok := unaryTestOps[p.peek(0)]; ok && p.peek(1) != "" {
+		opPos, x := p.args[p.i].Pos(), p.args[p.i+1]
+		if isTestOperator(x) {
+			return nil
+		}
+		p.i += 2
+		return &syntax.UnaryTest{OpPos: opPos, Op: op, X: x}
+	}
+	// a single word tests for non-empty
+	x := p.args[p.i]
+	if isTestOperator(x) {
+		return nil
+	}
+	p.i++
+	return x
+}
@@ -0,0 +1,52 @@
+package processor
+
+import (
+	"bytes"
+	"testing"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+func TestDesugar(t *testing.T) {
+	tests := []struct {
+		name   string
+		passes DesugarPass
+		text   string
+		want   string
+	}{
+		{"test equal", PassTest, "[ a = b ]", "[[ a = b ]]"},
+		{"test quoted", PassTest, `[ "$x" != "" ]`, `[[ "$x" != "" ]]`},
+		{"test unary", PassTest, "[ -f /tmp ]", "[[ -f /tmp ]]"},
+		{"test and", PassTest, `[ -n "$x" -a "$x" = y ]`, `[[ -n "$x" && "$x" = y ]]`},
+		{"test integer", PassTest, "[ x -eq 0 ]", "[ x -eq 0 ]"},
+		{"test unquoted expansion", PassTest, "[ $x = y ]", "[ $x = y ]"},
+		{"test glob", PassTest, `[ "$x" = a* ]`, `[ "$x" = a* ]`},
+		{"test mixed word", PassTest, `[ a"$x" = y ]`, `[ a"$x" = y ]`},
+		{"test operator operand", PassTest, "[ -n = x ]", "[ -n = x ]"},
+		{"test lone operator", PassTest, "[ -n ]", "[ -n ]"},
+		{"test quoted operator", PassTest, `[ "-n" ]`, `[[ "-n" ]]`},
+		{"param exp", PassParamExp, "echo ${x:-} ${y-}z", "echo $x ${y}z"},
+		{"param exp default", PassParamExp, "echo ${x:-a}", "echo ${x:-a}"},
+		// `${x:-}` is safe under `set -u`, unlike `$x`
+		{"param exp opt-in", 0, "set -u; echo ${x:-}", "set -u; echo ${x:-}"},
+		{"let", PassLet, "let x=1 y++", "((x = 1, y++))"},
+		{"time", PassTime, "time ls", "ls"},
+	}
+	printer := syntax.NewPrinter(syntax.SingleLine(true))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			Desugar(file, tt.passes)
+			var buf bytes.Buffer
+			if err := printer.Print(&buf, file); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want+"\n" {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `diffItem` is a function, assignment or other statement at the top level.
+type diffItem struct {
+	kind string
+	// function or variable name, else ""
+	name string
+	node syntax.Node
+	// source printed on a single line, so whitespace is ignored
+	print string
+}
+
+// `Diff` compares the top-level definitions of two versions of a script, so a session
+// can redefine only what changed e.g. on hot-reload. Comments are ignored, given files
+// parsed without `KeepComments`.
+//   - functions are matched by name e.g. `f() { … }` or `function f { … }`
+//   - assignments are matched by name e.g. `x=1` or `a=(1 2)`, given statements only assigning
+//   - other statements are matched in order, where a removal and addition between
+//     the same unchanged statements is a modification
+//
+// Changes are ordered by their position in the new text, else the old.
+func Diff(oldFile, newFile *syntax.File) []DiffChange {
+	oldItems, newItems := diffItems(oldFile), diffItems(newFile)
+	changes := []DiffChange{}
+
+	// named items, where the nth definition of a name matches the nth definition
+	oldNamed := map[string][]diffItem{}
+	for _, item := range oldItems {
+		if item.name != "" {
+			key := item.kind + " " + item.name
+			oldNamed[key] = append(oldNamed[key], item)
+		}
+	}
+	for _, item := range newItems {
+		if item.name == "" {
+			continue
+		}
+		key := item.kind + " " + item.name
+		if len(oldNamed[key]) == 0 {
+			changes = append(changes, diffChange("added", nil, &item))
+			continue
+		}
+		old := oldNamed[key][0]
+		oldNamed[key] = oldNamed[key][1:]
+		if old.print != item.print {
+			changes = append(changes, diffChange("modified", &old, &item))
+		}
+	}
+	for _, item := range oldItems {
+		key := item.kind + " " + item.name
+		if item.name != "" && len(oldNamed[key]) > 0 && oldNamed[key][0].node == item.node {
+			oldNamed[key] = oldNamed[key][1:]
+			changes = append(changes, diffChange("removed", &item, nil))
+		}
+	}
+
+	changes = append(changes, diffStatements(unnamed(oldItems), unnamed(newItems))...)
+
+	slices.SortStableFunc(changes, func(a, b DiffChange) int {
+		return int(changeOffset(a)) - int(changeOffset(b))
+	})
+	return changes
+}
+
+func diffItems(file *syntax.File) []diffItem {
+	items := []diffItem{}
+	for _, stmt := range file.Stmts {
+		if fn, ok := stmt.Cmd.(*syntax.FuncDecl); ok {
+			items = append(items, diffItem{kind: "function", name: fn.Name.Value, node: stmt, print: printNode(stmt)})
+			continue
+		}
+		call, ok := stmt.Cmd.(*syntax.CallExpr)
+		if ok && len(call.Args) == 0 && len(stmt.Redirs) == 0 && !stmt.Background && !stmt.Negated {
+			for _, assign := range call.Assigns {
+				items = append(items, diffItem{kind: "assignment", name: assign.Name.Value, node: assign, print: printNode(assign)})
+			}
+			continue
+		}
+		items = append(items, diffItem{kind: "statement", node: stmt, print: printNode(stmt)})
+	}
+	return items
+}
+
+var (
+	singleLinePrinter = syntax.NewPrinter(syntax.SingleLine(true))
+	defaultPrinter    = syntax.NewPrinter()
+)
+
+func printNode(node syntax.Node) string {
+	var sb strings.Builder
+	if err := singleLinePrinter.Print(&sb, node); err != nil {
+		// e.g. heredocs span lines
+		sb.Reset()
+		defaultPrinter.Print(&sb, node)
+	}
+	return sb.String()
+}
+
+func unnamed(items []diffItem) []diffItem {
+	return slices.DeleteFunc(slices.Clone(items), func(item diffItem) bool {
+		return item.name != ""
+	})
+}
+
+// `diffStatements` compares statements via their longest common subsequence.
+func diffStatements(olds, news []diffItem) []DiffChange {
+	// lcs[i][j] is the length of the longest common subsequence of `olds[i:]` and `news[j:]`
+	lcs := make([][]int, len(olds)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(news)+1)
+	}
+	for i := len(olds) - 1; i >= 0; i-- {
+		for j := len(news) - 1; j >= 0; j-- {
+			if olds[i].print == news[j].print {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	changes := []DiffChange{}
+	var removed, added []diffItem
+	// pairs the statements between unchanged ones
+	flush := func() {
+		for k := 0; k < max(len(removed), len(added)); k++ {
+			switch {
+			case k < len(removed) && k < len(added):
+				changes = append(changes, diffChange("modified", &removed[k], &added[k]))
+			case k < len(removed):
+				changes = append(changes, diffChange("removed", &removed[k], nil))
+			default:
+				changes = append(changes, diffChange("added", nil, &added[k]))
+			}
+		}
+		removed, added = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(olds) || j < len(news) {
+		switch {
+		case i < len(olds) && j < len(news) && olds[i].print == news[j].print:
+			flush()
+			i++
+			j++
+		case j < len(news) && (i == len(olds) || lcs[i][j+1] >= lcs[i+1][j]):
+			added = append(added, news[j])
+			j++
+		default:
+			removed = append(removed, olds[i])
+			i++
+		}
+	}
+	flush()
+	return changes
+}
+
+func diffChange(change string, before, after *diffItem) DiffChange {
+	c := DiffChange{Change: change}
+	if before != nil {
+		c.Kind, c.Name = before.kind, before.name
+		c.OldPos, c.OldEnd = mapNullablePos(before.node.Pos()), mapNullablePos(before.node.End())
+	}
+	if after != nil {
+		c.Kind, c.Name = after.kind, after.name
+		c.NewPos, c.NewEnd = mapNullablePos(after.node.Pos()), mapNullablePos(after.node.End())
+	}
+	return c
+}
+
+func changeOffset(c DiffChange) uint {
+	if c.NewPos != nil {
+		return c.NewPos.Offset
+	}
+	return c.OldPos.Offset
+}
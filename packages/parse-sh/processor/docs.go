@@ -0,0 +1,176 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Docs` attaches contiguous leading comments, and a trailing comment on the same line,
+// to function declarations, variable assignments and case items.
+// Every function is included, whereas assignments and case items only if commented.
+//
+// Comment text is parsed for tags e.g.
+//
+//	# Spawn an NPC.
+//	# @arg npcKey unique identifier
+//	# @example spawn rob
+//	# @returns the npc's key
+//
+// where an `@example` continues until the next tag.
+func Docs(file *syntax.File) []DocEntry {
+	docs := []DocEntry{}
+	stack := []syntax.Node{}
+
+	enclosing := func() string {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if fn, ok := stack[i].(*syntax.FuncDecl); ok {
+				return fn.Name.Value
+			}
+		}
+		return ""
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		defer func() { stack = append(stack, node) }()
+
+		switch node := node.(type) {
+		case *syntax.Stmt:
+			comments := docComments(node.Comments, node.Pos(), node.End())
+			switch cmd := node.Cmd.(type) {
+			case *syntax.FuncDecl:
+				docs = append(docs, docEntry("function", cmd.Name.Value, cmd, comments, enclosing()))
+			case *syntax.CallExpr:
+				if len(cmd.Args) > 0 || len(comments) == 0 {
+					break
+				}
+				for _, assign := range cmd.Assigns {
+					docs = append(docs, docEntry("variable", assign.Name.Value, assign, comments, enclosing()))
+				}
+			case *syntax.DeclClause:
+				if len(comments) == 0 {
+					break
+				}
+				for _, assign := range cmd.Args {
+					if assign.Name != nil {
+						docs = append(docs, docEntry("variable", assign.Name.Value, assign, comments, enclosing()))
+					}
+				}
+			}
+		case *syntax.CaseItem:
+			comments := docComments(node.Comments, node.Pos(), node.End())
+			if len(comments) == 0 {
+				break
+			}
+			patterns := make([]string, len(node.Patterns))
+			for i, word := range node.Patterns {
+				patterns[i] = wordText(word)
+			}
+			docs = append(docs, docEntry("case-item", strings.Join(patterns, "|"), node, comments, enclosing()))
+		}
+		return true
+	})
+	return docs
+}
+
+// `docComments` keeps the leading comments on contiguous lines ending just before `pos`,
+// excluding a shebang, and any trailing comment on the line of `end`.
+func docComments(comments []syntax.Comment, pos, end syntax.Pos) []syntax.Comment {
+	leading := []syntax.Comment{}
+	nextLine := pos.Line()
+	for i := len(comments) - 1; i >= 0; i-- {
+		c := comments[i]
+		if c.Hash.Offset() >= pos.Offset() {
+			continue
+		}
+		if c.Hash.Line() != nextLine-1 || c.Hash.Offset() == 0 && strings.HasPrefix(c.Text, "!") {
+			break
+		}
+		leading = append([]syntax.Comment{c}, leading...)
+		nextLine = c.Hash.Line()
+	}
+	for _, c := range comments {
+		if c.Hash.Offset() >= end.Offset() && c.Hash.Line() == end.Line() {
+			leading = append(leading, c)
+		}
+	}
+	return leading
+}
+
+func docEntry(kind, name string, node syntax.Node, comments []syntax.Comment, function string) DocEntry {
+	entry := DocEntry{
+		Kind:     kind,
+		Name:     name,
+		Function: function,
+		Args:     []DocArg{},
+		Examples: []string{},
+		Tags:     []DocTag{},
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+	}
+	if len(comments) > 0 {
+		entry.CommentPos = mapNullablePos(comments[0].Pos())
+		entry.CommentEnd = mapNullablePos(comments[len(comments)-1].End())
+	}
+
+	lines := make([]string, len(comments))
+	for i, c := range comments {
+		// strip at most one space, preserving e.g. the indentation of examples
+		lines[i] = strings.TrimPrefix(c.Text, " ")
+	}
+	parseDocTags(&entry, lines)
+	return entry
+}
+
+// `parseDocTags` splits lines into a description followed by tagged sections.
+func parseDocTags(entry *DocEntry, lines []string) {
+	description := []string{}
+	tag, text := "", []string{}
+
+	flush := func() {
+		body := strings.TrimRight(strings.Join(text, "\n"), "\n ")
+		switch tag {
+		case "":
+			description = append(description, text...)
+		case "arg", "param":
+			name, desc, _ := strings.Cut(strings.TrimSpace(body), " ")
+			entry.Args = append(entry.Args, DocArg{Name: name, Description: strings.TrimSpace(desc)})
+		case "example":
+			entry.Examples = append(entry.Examples, strings.Trim(body, "\n"))
+		case "returns", "return":
+			entry.Returns = strings.TrimSpace(body)
+		default:
+			entry.Tags = append(entry.Tags, DocTag{Tag: tag, Text: strings.TrimSpace(body)})
+		}
+		tag, text = "", nil
+	}
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "@") && len(trimmed) > 1 {
+			flush()
+			name, rest, _ := strings.Cut(trimmed[1:], " ")
+			tag, text = name, []string{rest}
+			continue
+		}
+		if tag == "example" {
+			text = append(text, line)
+		} else {
+			text = append(text, trimmed)
+		}
+	}
+	flush()
+	entry.Description = strings.TrimSpace(strings.Join(description, "\n"))
+}
+
+func wordText(word *syntax.Word) string {
+	var sb strings.Builder
+	if err := syntax.NewPrinter().Print(&sb, word); err != nil {
+		return word.Lit()
+	}
+	return sb.String()
+}
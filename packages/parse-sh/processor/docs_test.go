@@ -0,0 +1,60 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDocs(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		kind        string
+		entryName   string
+		description string
+	}{
+		{"function", "# Greets.\nf() { :; }", "function", "f", "Greets."},
+		{"shebang", "#!/bin/bash\n# Greets.\nf() { :; }", "function", "f", "Greets."},
+		{"shebang only", "#!/bin/bash\nf() { :; }", "function", "f", ""},
+		{"not contiguous", "# Other.\n\n# Greets.\nf() { :; }", "function", "f", "Greets."},
+		{"trailing", "x=1 # The count.", "variable", "x", "The count."},
+		{"case item", "case $1 in\n# Starts.\nstart) ;;\nesac", "case-item", "start", "Starts."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{KeepComments: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			docs := Docs(file)
+			if len(docs) != 1 {
+				t.Fatalf("docs = %+v, want one entry", docs)
+			}
+			doc := docs[0]
+			if doc.Kind != tt.kind || doc.Name != tt.entryName || doc.Description != tt.description {
+				t.Errorf("got %s %q described %q, want %s %q described %q", doc.Kind, doc.Name, doc.Description, tt.kind, tt.entryName, tt.description)
+			}
+		})
+	}
+}
+
+func TestDocTags(t *testing.T) {
+	text := "# Spawn an NPC.\n# @arg npcKey unique identifier\n# @example spawn rob\n#   --at 0,0\n# @returns the npc's key\n# @since 1.0\nspawn() { :; }"
+	file, err := Parse(text, "", ParserOptions{KeepComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := Docs(file)[0]
+	if doc.Description != "Spawn an NPC." || doc.Returns != "the npc's key" {
+		t.Errorf("description %q and returns %q", doc.Description, doc.Returns)
+	}
+	if want := []DocArg{{Name: "npcKey", Description: "unique identifier"}}; !slices.Equal(doc.Args, want) {
+		t.Errorf("args = %+v, want %+v", doc.Args, want)
+	}
+	if want := []string{"spawn rob\n  --at 0,0"}; !slices.Equal(doc.Examples, want) {
+		t.Errorf("examples = %q, want %q", doc.Examples, want)
+	}
+	if want := []DocTag{{Tag: "since", Text: "1.0"}}; !slices.Equal(doc.Tags, want) {
+		t.Errorf("tags = %+v, want %+v", doc.Tags, want)
+	}
+}
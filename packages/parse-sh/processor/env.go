@@ -0,0 +1,118 @@
+package processor
+
+import (
+	"maps"
+	"slices"
+
+	"mvdan.cc/sh/v3/expand"
+)
+
+// `HostEnviron` is an `expand.WriteEnviron` whose variables live elsewhere,
+// e.g. in the JS session. Reads go through `Lookup` and writes through `Assign`.
+//
+// Every successful write is also kept in an overlay, so later reads within the
+// same evaluation see it even if the host applies writes lazily, and is
+// recorded in `Assigns` so it can be reported back, e.g. `i++` or `x+=2`.
+type HostEnviron struct {
+	Lookup func(name string) expand.Variable
+	Assign func(name string, vr expand.Variable) error
+	Names  func() []string
+
+	Assigns []VarAssign
+	overlay map[string]expand.Variable
+}
+
+func (env *HostEnviron) Get(name string) expand.Variable {
+	if vr, ok := env.overlay[name]; ok {
+		return vr
+	}
+	if env.Lookup == nil {
+		return expand.Variable{}
+	}
+	return env.Lookup(name)
+}
+
+func (env *HostEnviron) Each(fn func(name string, vr expand.Variable) bool) {
+	seen := map[string]bool{}
+	if env.Names != nil {
+		for _, name := range env.Names() {
+			seen[name] = true
+			if !fn(name, env.Get(name)) {
+				return
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(env.overlay)) {
+		if !seen[name] && !fn(name, env.overlay[name]) {
+			return
+		}
+	}
+}
+
+func (env *HostEnviron) Set(name string, vr expand.Variable) error {
+	if vr.Kind == expand.KeepValue {
+		prev := env.Get(name)
+		prev.Local, prev.Exported, prev.ReadOnly = vr.Local, vr.Exported, vr.ReadOnly
+		vr = prev
+	}
+	if prev := env.Get(name); prev.ReadOnly && prev.IsSet() {
+		return &EvalError{Kind: "ReadOnly", Message: name + ": readonly variable"}
+	}
+	if env.Assign != nil {
+		if err := env.Assign(name, vr); err != nil {
+			return err
+		}
+	}
+	if env.overlay == nil {
+		env.overlay = map[string]expand.Variable{}
+	}
+	env.overlay[name] = vr
+	env.Assigns = append(env.Assigns, mapVariable(name, vr))
+	return nil
+}
+
+func mapVariable(name string, vr expand.Variable) VarAssign {
+	return VarAssign{
+		Name:     name,
+		Unset:    !vr.IsSet(),
+		Kind:     ValueKindName(vr.Kind),
+		Value:    vr.Str,
+		List:     vr.List,
+		Map:      vr.Map,
+		Local:    vr.Local,
+		Exported: vr.Exported,
+		ReadOnly: vr.ReadOnly,
+	}
+}
+
+// `ValueKindName` is the JS-facing name of an `expand.ValueKind`.
+func ValueKindName(kind expand.ValueKind) string {
+	switch kind {
+	case expand.String:
+		return "string"
+	case expand.NameRef:
+		return "nameref"
+	case expand.Indexed:
+		return "indexed"
+	case expand.Associative:
+		return "associative"
+	default:
+		return ""
+	}
+}
+
+// `ParseValueKind` inverts `ValueKindName`, treating anything else as unknown.
+func ParseValueKind(name string) expand.ValueKind {
+	switch name {
+	case "string":
+		return expand.String
+	case "nameref":
+		return expand.NameRef
+	case "indexed":
+		return expand.Indexed
+	case "associative":
+		return expand.Associative
+	default:
+		return expand.Unknown
+	}
+}
@@ -0,0 +1,183 @@
+package processor
+
+import (
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/expand"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+type ExpandMode int
+
+const (
+	// Words as command arguments: brace, tilde, parameter, command, arithmetic
+	// expansion, IFS splitting, globbing and quote removal.
+	ExpandFields ExpandMode = iota
+	// Each word as a single string, as in an assignment `x=$y`.
+	ExpandLiteral
+	// Each word as a here-document body: no tilde or brace expansion, no globbing.
+	ExpandDocument
+	// Each word as a pattern e.g. for `case`, with quoted parts escaped.
+	ExpandPattern
+)
+
+// `Expand` performs shell word expansion on `text`, a list of words e.g. `"$x" ${a[@]} ~/foo`.
+//
+// Variables are read and written through `host.Env` (so `${x:=y}` is reported as an assignment)
+// and command substitutions are delegated to `host.CmdSubst`.
+// Arithmetic expansions use `Eval`, so `$(( 16#ff ))` follows bash rather than `expand.Arithm`,
+// except within e.g. `${x:-…}` where they must be lazy, see `preArithm`.
+func Expand(text string, mode ExpandMode, host EvalHost) ExpandResult {
+	if host.Env == nil {
+		host.Env = &HostEnviron{}
+	}
+	e := &evaluator{host: host, text: text}
+	e.cfg = &expand.Config{
+		Env:      host.Env,
+		CmdSubst: e.cmdSubst,
+	}
+
+	fields, err := e.expandText(mode)
+	result := ExpandResult{
+		Fields:  fields,
+		Assigns: host.Env.Assigns,
+	}
+	if err != nil {
+		result.Error = e.mapError(err)
+	}
+	return result
+}
+
+func (e *evaluator) expandText(mode ExpandMode) ([]string, error) {
+	var words []*syntax.Word
+	if mode == ExpandDocument {
+		word, err := syntax.NewParser().Document(strings.NewReader(e.text))
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, word)
+	} else {
+		err := syntax.NewParser().Words(strings.NewReader(e.text), func(word *syntax.Word) bool {
+			words = append(words, word)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e.expandWords(words, mode)
+}
+
+func (e *evaluator) expandWords(words []*syntax.Word, mode ExpandMode) ([]string, error) {
+	fields := []string{}
+	if mode == ExpandFields {
+		for _, word := range words {
+			if err := e.preArithm(word); err != nil {
+				return nil, err
+			}
+		}
+		expanded, err := expand.Fields(e.cfg, words...)
+		return append(fields, expanded...), err
+	}
+
+	for _, word := range words {
+		if err := e.preArithm(word); err != nil {
+			return nil, err
+		}
+		var field string
+		var err error
+		switch mode {
+		case ExpandDocument:
+			field, err = expand.Document(e.cfg, word)
+		case ExpandPattern:
+			field, err = expand.Pattern(e.cfg, word)
+		default:
+			field, err = expand.Literal(e.cfg, word)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// `preArithm` replaces each arithmetic expansion in a word by its value.
+// This happens before the rest of the word is expanded, which only differs from
+// bash when an earlier part assigns a variable used later e.g. `${x:=1}$((x+1))`.
+//
+// The word of e.g. `${x:-…}` is only expanded if bash would, since `expand` always expands it,
+// so `"${x:-$((i++))}"` leaves `i` alone if `x` is set.
+func (e *evaluator) preArithm(word *syntax.Word) error {
+	var err error
+	var visit func(node syntax.Node) bool
+	visit = func(node syntax.Node) bool {
+		if err != nil {
+			return false
+		}
+		switch node := node.(type) {
+		case *syntax.Word:
+			err = e.replaceArithm(node.Parts)
+		case *syntax.DblQuoted:
+			err = e.replaceArithm(node.Parts)
+		case *syntax.ParamExp:
+			if node.Exp != nil && !e.expUsed(node) {
+				node.Exp.Word = nil
+			}
+		case *syntax.CmdSubst:
+			return false // expanded by the host
+		}
+		return true
+	}
+	syntax.Walk(word, visit)
+	return err
+}
+
+// `expUsed` is false if the word of e.g. `${x:-word}` or `${x+word}` is not expanded.
+// Parameters with an index are assumed to use it, rather than evaluating the index twice.
+func (e *evaluator) expUsed(pe *syntax.ParamExp) bool {
+	if pe.Index != nil || pe.Excl || pe.Length || pe.Width || pe.Slice != nil || pe.Repl != nil {
+		return true
+	}
+	op := pe.Exp.Op
+	switch op {
+	case syntax.AlternateUnset, syntax.AlternateUnsetOrNull, syntax.DefaultUnset, syntax.DefaultUnsetOrNull,
+		syntax.ErrorUnset, syntax.ErrorUnsetOrNull, syntax.AssignUnset, syntax.AssignUnsetOrNull:
+	default:
+		return true
+	}
+	value, err := expand.Literal(e.cfg, &syntax.Word{Parts: []syntax.WordPart{&syntax.ParamExp{Param: pe.Param}}})
+	if err != nil {
+		return true
+	}
+	set := value != "" || e.host.Env.Get(pe.Param.Value).IsSet()
+	switch op {
+	case syntax.AlternateUnset:
+		return set
+	case syntax.AlternateUnsetOrNull:
+		return value != ""
+	case syntax.DefaultUnset, syntax.ErrorUnset, syntax.AssignUnset:
+		return !set
+	default: // the `:` variants
+		return value == ""
+	}
+}
+
+func (e *evaluator) replaceArithm(parts []syntax.WordPart) error {
+	for i, part := range parts {
+		if exp, ok := part.(*syntax.ArithmExp); ok {
+			value, err := e.arithm(exp.X)
+			if err != nil {
+				return err
+			}
+			parts[i] = &syntax.Lit{
+				ValuePos: exp.Pos(),
+				ValueEnd: exp.End(),
+				Value:    strconv.FormatInt(value, 10),
+			}
+		}
+	}
+	return nil
+}
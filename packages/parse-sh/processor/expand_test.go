@@ -0,0 +1,132 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+
+	"mvdan.cc/sh/v3/expand"
+)
+
+// `testEnv` is a host environment of string variables.
+func testEnv(vars map[string]string) *HostEnviron {
+	return &HostEnviron{Lookup: func(name string) expand.Variable {
+		if value, ok := vars[name]; ok {
+			return expand.Variable{Set: true, Kind: expand.String, Str: value}
+		}
+		return expand.Variable{}
+	}}
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		text    string
+		mode    EvalMode
+		vars    map[string]string
+		value   int64
+		status  int
+		assigns []string
+		err     string
+	}{
+		{text: "1 + 2 * 3", value: 7},
+		{text: "16#ff", value: 255},
+		{text: "64#_", value: 63},
+		{text: "0x1f + 010", value: 39},
+		{text: "2#102", err: "ValueTooGreatForBase"},
+		{text: "65#1", err: "BadBase"},
+		{text: "1 / 0", err: "DivisionByZero"},
+		{text: "2 ** -1", err: "NegativeExponent"},
+		{text: "0", value: 0, status: 1},
+		{text: "x", vars: map[string]string{"x": "1+2"}, value: 3},
+		{text: "i++", vars: map[string]string{"i": "4"}, value: 4, assigns: []string{"i"}},
+		{text: "0 && i++", value: 0, status: 1},
+		{text: "1 || i++", value: 1},
+		{text: "2 ? 5 : 6", value: 5},
+		{text: "a[1] = 3", value: 3, assigns: []string{"a"}},
+		{text: "1 << 65", value: 2},
+		{text: "$(( 16#10 ))", value: 16},
+		{text: "let x=2 y=x+1", value: 3, assigns: []string{"x", "y"}},
+		{text: "x -eq 3", mode: EvalTest, vars: map[string]string{"x": "3"}, value: 1},
+		{text: "$x == a*", mode: EvalTest, vars: map[string]string{"x": "abc"}, value: 1},
+		{text: "-z $x", mode: EvalTest, value: 1},
+		{text: "-f x", mode: EvalTest, status: 2, err: "Unsupported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			result := Eval(tt.text, tt.mode, EvalHost{Env: testEnv(tt.vars)})
+			if tt.err != "" {
+				if result.Error == nil || result.Error.Kind != tt.err {
+					t.Fatalf("error = %+v, want %s", result.Error, tt.err)
+				}
+				return
+			}
+			if result.Error != nil {
+				t.Fatalf("unexpected error %+v", result.Error)
+			}
+			if result.Value != tt.value || result.Status != tt.status {
+				t.Errorf("value, status = %d, %d, want %d, %d", result.Value, result.Status, tt.value, tt.status)
+			}
+			names := []string{}
+			for _, assign := range result.Assigns {
+				names = append(names, assign.Name)
+			}
+			if !slices.Equal(names, append([]string{}, tt.assigns...)) {
+				t.Errorf("assigns = %v, want %v", names, tt.assigns)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		text    string
+		mode    ExpandMode
+		vars    map[string]string
+		fields  []string
+		assigns []string
+		err     string
+	}{
+		{text: `a "$x" $y`, vars: map[string]string{"x": "1 2", "y": "3 4"}, fields: []string{"a", "1 2", "3", "4"}},
+		{text: `$(( 16#ff ))`, fields: []string{"255"}},
+		{text: `"$(( i++ ))" $i`, vars: map[string]string{"i": "1"}, fields: []string{"1", "2"}, assigns: []string{"i"}},
+		{text: `${x:=y}`, fields: []string{"y"}, assigns: []string{"x"}},
+		// only evaluated if bash would
+		{text: `${x:-$((1/0))}`, vars: map[string]string{"x": "set"}, fields: []string{"set"}},
+		{text: `"${x:-$((i++))}" $i`, vars: map[string]string{"x": "set", "i": "1"}, fields: []string{"set", "1"}},
+		{text: `${x:+$((i++))} $i`, vars: map[string]string{"i": "1"}, fields: []string{"1"}},
+		{text: `${x:-$((i+1))}`, vars: map[string]string{"i": "1"}, fields: []string{"2"}},
+		{text: `${x:=$((i++))} $i`, vars: map[string]string{"x": "set", "i": "1"}, fields: []string{"set", "1"}},
+		{text: `${x?$((1/0))}`, vars: map[string]string{"x": ""}, fields: []string{}},
+		// as in bash, replacements and slices are always expanded
+		{text: `${x/a/$((1/0))}`, vars: map[string]string{"x": "b"}, err: "DivisionByZero"},
+		{text: `${x:0:$((i++))} $i`, vars: map[string]string{"x": "ab", "i": "1"}, fields: []string{"a", "2"}, assigns: []string{"i"}},
+		{text: `$((1/0))`, err: "DivisionByZero"},
+		{text: `$(ls)`, err: "CmdSubst"},
+		{text: `a*`, mode: ExpandPattern, fields: []string{"a*"}},
+		{text: `"a*"`, mode: ExpandPattern, fields: []string{`a\*`}},
+		{text: `$x`, mode: ExpandLiteral, vars: map[string]string{"x": "1 2"}, fields: []string{"1 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			result := Expand(tt.text, tt.mode, EvalHost{Env: testEnv(tt.vars)})
+			if tt.err != "" {
+				if result.Error == nil || result.Error.Kind != tt.err {
+					t.Fatalf("error = %+v, want %s", result.Error, tt.err)
+				}
+				return
+			}
+			if result.Error != nil {
+				t.Fatalf("unexpected error %+v", result.Error)
+			}
+			if !slices.Equal(result.Fields, tt.fields) {
+				t.Errorf("fields = %q, want %q", result.Fields, tt.fields)
+			}
+			names := []string{}
+			for _, assign := range result.Assigns {
+				names = append(names, assign.Name)
+			}
+			if !slices.Equal(names, append([]string{}, tt.assigns...)) {
+				t.Errorf("assigns = %v, want %v", names, tt.assigns)
+			}
+		})
+	}
+}
@@ -0,0 +1,603 @@
+package processor
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/expand"
+	"mvdan.cc/sh/v3/pattern"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `ExtGlob` extends `pattern.Mode` with bash's `shopt -s extglob` i.e.
+// `?(a|b)`, `*(a|b)`, `+(a|b)`, `@(a|b)` and `!(a|b)`.
+const ExtGlob pattern.Mode = 1 << 8
+
+type GlobOptions struct {
+	ExtGlob    bool
+	GlobStar   bool
+	NullGlob   bool
+	NoCaseGlob bool
+	DotGlob    bool
+	// Relative patterns are resolved against `Cwd`, else `$PWD`, else `/`.
+	Cwd string
+}
+
+// `Glob` expands words e.g. `/home/*.sh npc-? **/config` like `Expand`,
+// additionally performing pathname expansion against `host.ReadDir`.
+//
+// Only globs written in the word itself are expanded, e.g. `$dir/*.sh` but not
+// an unquoted `$pat` whose value is `*.sh`.
+func Glob(text string, opts GlobOptions, host EvalHost) ExpandResult {
+	if host.Env == nil {
+		host.Env = &HostEnviron{}
+	}
+	e := &evaluator{host: host, text: text}
+	e.cfg = &expand.Config{
+		Env:      host.Env,
+		CmdSubst: e.cmdSubst,
+	}
+	if opts.Cwd == "" {
+		opts.Cwd = host.Env.Get("PWD").String()
+	}
+	if opts.Cwd == "" {
+		opts.Cwd = "/"
+	}
+
+	fields, err := e.globText(opts)
+	result := ExpandResult{
+		Fields:  fields,
+		Assigns: host.Env.Assigns,
+	}
+	if err != nil {
+		result.Error = e.mapError(err)
+	}
+	return result
+}
+
+func (e *evaluator) globText(opts GlobOptions) ([]string, error) {
+	fields := []string{}
+	var globErr error
+	err := syntax.NewParser().Words(strings.NewReader(e.text), func(word *syntax.Word) bool {
+		var expanded []string
+		if expanded, globErr = e.globWord(word, opts); globErr != nil {
+			return false
+		}
+		fields = append(fields, expanded...)
+		return true
+	})
+	if err == nil {
+		err = globErr
+	}
+	return fields, err
+}
+
+func (e *evaluator) globWord(word *syntax.Word, opts GlobOptions) ([]string, error) {
+	if err := e.preArithm(word); err != nil {
+		return nil, err
+	}
+	extGlobToLit(word)
+
+	afterBraces := []*syntax.Word{word}
+	if syntax.SplitBraces(word) {
+		afterBraces = expand.Braces(word)
+	}
+
+	fields := []string{}
+	for _, word := range afterBraces {
+		if !wordHasGlob(word, opts.ExtGlob) {
+			expanded, err := expand.Fields(e.cfg, word)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, expanded...)
+			continue
+		}
+
+		pat, err := expand.Pattern(e.cfg, word)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := e.glob(pat, opts)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(matches) > 0:
+			fields = append(fields, matches...)
+		case !opts.NullGlob:
+			fields = append(fields, unescapePattern(pat))
+		}
+	}
+	return fields, nil
+}
+
+// `extGlobToLit` replaces parsed `@(a|b)` etc. by literal text, so expansion
+// passes them through for `compileGlob`.
+func extGlobToLit(word *syntax.Word) {
+	for i, part := range word.Parts {
+		if eg, ok := part.(*syntax.ExtGlob); ok {
+			word.Parts[i] = &syntax.Lit{
+				ValuePos: eg.Pos(),
+				ValueEnd: eg.End(),
+				Value:    eg.Op.String() + eg.Pattern.Value + ")",
+			}
+		}
+	}
+}
+
+// `wordHasGlob` reports whether an unquoted literal part contains glob metacharacters.
+func wordHasGlob(word *syntax.Word, extGlob bool) bool {
+	for _, part := range word.Parts {
+		if lit, ok := part.(*syntax.Lit); ok && hasGlobMeta(lit.Value, extGlob) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasGlobMeta(pat string, extGlob bool) bool {
+	if pattern.HasMeta(pat, 0) {
+		return true
+	}
+	return extGlob && extGlobStart(pat) >= 0
+}
+
+// `extGlobStart` returns the index of the first unescaped `?(`, `*(`, `+(`, `@(` or `!(`.
+func extGlobStart(pat string) int {
+	for i := 0; i+1 < len(pat); i++ {
+		switch pat[i] {
+		case '\\':
+			i++
+		case '?', '*', '+', '@', '!':
+			if pat[i+1] == '(' {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func unescapePattern(pat string) string {
+	if !strings.Contains(pat, `\`) {
+		return pat
+	}
+	var sb strings.Builder
+	for i := 0; i < len(pat); i++ {
+		if pat[i] == '\\' && i+1 < len(pat) {
+			i++
+		}
+		sb.WriteByte(pat[i])
+	}
+	return sb.String()
+}
+
+// `glob` performs pathname expansion of a single pattern, returning sorted matches.
+func (e *evaluator) glob(pat string, opts GlobOptions) ([]string, error) {
+	if e.host.ReadDir == nil {
+		return nil, &EvalError{Kind: "Unsupported", Message: "pathname expansion is not available"}
+	}
+	segments := strings.Split(pat, "/")
+	matches := []string{""}
+	if strings.HasPrefix(pat, "/") {
+		matches[0] = "/"
+		segments = segments[1:]
+	}
+
+	mode := pattern.Filenames | pattern.EntireString
+	if opts.NoCaseGlob {
+		mode |= pattern.NoGlobCase
+	}
+	if opts.ExtGlob {
+		mode |= ExtGlob
+	}
+
+	for i, segment := range segments {
+		wantDir := i < len(segments)-1
+		var next []string
+
+		switch {
+		case segment == "", segment == ".", segment == "..":
+			for _, match := range matches {
+				next = append(next, joinGlob(match, segment))
+			}
+
+		case !hasGlobMeta(segment, opts.ExtGlob):
+			name := unescapePattern(segment)
+			for _, match := range matches {
+				entries, err := e.readDir(resolvePath(opts.Cwd, match))
+				if err != nil {
+					continue
+				}
+				for _, entry := range entries {
+					if entry.Name() == name && (!wantDir || entry.IsDir()) {
+						next = append(next, joinGlob(match, name))
+						break
+					}
+				}
+			}
+
+		case segment == "**" && opts.GlobStar:
+			// depth-first, each directory before its descendants, and a trailing `**` matches files too
+			for _, match := range matches {
+				stack := []string{match}
+				for len(stack) > 0 {
+					dir := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					if dir != "" || wantDir {
+						next = append(next, dir) // "" lets `**/x` match `x`
+					}
+					entries, _ := e.readDir(resolvePath(opts.Cwd, dir))
+					for _, entry := range entries {
+						if !entry.IsDir() && !wantDir && (opts.DotGlob || !strings.HasPrefix(entry.Name(), ".")) {
+							next = append(next, joinGlob(dir, entry.Name()))
+						}
+					}
+					for _, entry := range slices.Backward(entries) {
+						if entry.IsDir() && (opts.DotGlob || !strings.HasPrefix(entry.Name(), ".")) {
+							stack = append(stack, joinGlob(dir, entry.Name())+"/")
+						}
+					}
+				}
+			}
+			if !wantDir {
+				for i, match := range next {
+					next[i] = strings.TrimSuffix(match, "/")
+				}
+			}
+
+		default:
+			matcher, err := compileGlob(segment, mode)
+			if err != nil {
+				return nil, &EvalError{Kind: "BadPattern", Message: err.Error()}
+			}
+			matchHidden := opts.DotGlob || strings.HasPrefix(segment, ".")
+			for _, match := range matches {
+				entries, err := e.readDir(resolvePath(opts.Cwd, match))
+				if err != nil {
+					continue
+				}
+				for _, entry := range entries {
+					name := entry.Name()
+					if wantDir && !entry.IsDir() || !matchHidden && strings.HasPrefix(name, ".") {
+						continue
+					}
+					if matcher(name) {
+						next = append(next, joinGlob(match, name))
+					}
+				}
+			}
+		}
+		matches = next
+	}
+
+	slices.Sort(matches)
+	return slices.Compact(matches), nil
+}
+
+func (e *evaluator) readDir(dir string) ([]fs.DirEntry, error) {
+	entries, err := e.host.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	return slices.SortedFunc(slices.Values(entries), func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	}), nil
+}
+
+func resolvePath(cwd, p string) string {
+	if path.IsAbs(p) {
+		return path.Clean(p)
+	}
+	return path.Join(cwd, p)
+}
+
+func joinGlob(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	if strings.HasSuffix(dir, "/") {
+		return dir + name
+	}
+	return dir + "/" + name
+}
+
+// `HostDirEntry` is a directory entry provided by the host's virtual filesystem.
+type HostDirEntry struct {
+	EntryName string
+	Dir       bool
+}
+
+func (d HostDirEntry) Name() string               { return d.EntryName }
+func (d HostDirEntry) IsDir() bool                { return d.Dir }
+func (d HostDirEntry) Info() (fs.FileInfo, error) { return nil, errors.ErrUnsupported }
+func (d HostDirEntry) Type() fs.FileMode {
+	if d.Dir {
+		return fs.ModeDir
+	}
+	return 0
+}
+
+var (
+	errNegation      = errors.New("!(…) cannot be expressed without lookahead")
+	errNegationWhere = errors.New("!(…) can only be expressed at the end of a pattern matching an entire string")
+)
+
+// `PatternRegexp` converts a shell pattern into a regular expression,
+// where `mode` may include `ExtGlob`.
+//
+// If `lookahead` is true the result targets JS, whose regular expressions
+// support lookahead, so `!(…)` is supported at the end of the pattern e.g. `*.!(sh)`.
+// Elsewhere the text it matches can't be delimited by a lookahead, so it is an error,
+// whereas `compileGlob` supports it. Flags such as `(?s)` are returned separately e.g. "s" or "si".
+func PatternRegexp(pat string, mode pattern.Mode, lookahead bool) (string, string, error) {
+	body, err := translatePattern(pat, mode&^pattern.EntireString, lookahead, mode&pattern.EntireString != 0)
+	if err != nil {
+		return "", "", err
+	}
+	if mode&pattern.EntireString != 0 {
+		body = "^" + body + "$"
+	}
+	flags := "s"
+	if mode&pattern.NoGlobCase != 0 {
+		flags += "i"
+	}
+	if lookahead {
+		body = posixClassesToJs(body)
+	}
+	return body, flags, nil
+}
+
+// `compileGlob` returns a matcher for a shell pattern, where `mode` may include `ExtGlob`.
+// Go's regexp has no lookahead, so `!(…)` is matched by trying every split of the input.
+func compileGlob(pat string, mode pattern.Mode) (func(string) bool, error) {
+	start, end, ok := findNegation(pat, mode)
+	if !ok {
+		expr, flags, err := PatternRegexp(pat, mode|pattern.EntireString, false)
+		if err != nil {
+			return nil, err
+		}
+		rx, err := regexp.Compile("(?" + flags + ")" + expr)
+		if err != nil {
+			return nil, err
+		}
+		return rx.MatchString, nil
+	}
+
+	prefix, err := compileGlob(pat[:start], mode)
+	if err != nil {
+		return nil, err
+	}
+	negated, err := compileGlob("@"+pat[start+1:end+1], mode)
+	if err != nil {
+		return nil, err
+	}
+	suffix, err := compileGlob(pat[end+1:], mode)
+	if err != nil {
+		return nil, err
+	}
+	return func(s string) bool {
+		for i := 0; i <= len(s); i++ {
+			if !prefix(s[:i]) {
+				continue
+			}
+			for j := i; j <= len(s); j++ {
+				if mode&pattern.Filenames != 0 && strings.Contains(s[i:j], "/") {
+					break
+				}
+				if !negated(s[i:j]) && suffix(s[j:]) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+// `findNegation` finds the first top-level `!(…)`, returning the indices of `!` and `)`.
+func findNegation(pat string, mode pattern.Mode) (int, int, bool) {
+	if mode&ExtGlob == 0 {
+		return 0, 0, false
+	}
+	for i := 0; i < len(pat); i++ {
+		switch pat[i] {
+		case '\\':
+			i++
+		case '[':
+			i = classEnd(pat, i)
+		case '?', '*', '+', '@', '!':
+			if i+1 < len(pat) && pat[i+1] == '(' {
+				end := groupEnd(pat, i+1)
+				if end < 0 {
+					return 0, 0, false
+				}
+				if pat[i] == '!' {
+					return i, end, true
+				}
+				i = end
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// `translatePattern` converts a pattern without anchors, handling extglob groups
+// ourselves and delegating everything else to `pattern.Regexp`.
+// `atEnd` is true if the end of `pat` is the end of the string matched.
+func translatePattern(pat string, mode pattern.Mode, lookahead bool, atEnd bool) (string, error) {
+	var sb strings.Builder
+	chunkStart := 0
+
+	flush := func(to int) error {
+		if to <= chunkStart {
+			return nil
+		}
+		expr, err := pattern.Regexp(pat[chunkStart:to], mode&^(ExtGlob|pattern.NoGlobCase|pattern.EntireString))
+		if err != nil {
+			return err
+		}
+		sb.WriteString(strings.TrimPrefix(expr, "(?s)"))
+		return nil
+	}
+
+	for i := 0; i < len(pat); i++ {
+		switch pat[i] {
+		case '\\':
+			i++
+			continue
+		case '[':
+			i = classEnd(pat, i)
+			continue
+		case '?', '*', '+', '@', '!':
+			if mode&ExtGlob == 0 || i+1 >= len(pat) || pat[i+1] != '(' {
+				continue
+			}
+		default:
+			continue
+		}
+
+		end := groupEnd(pat, i+1)
+		if end < 0 {
+			return "", errors.New("extglob group was not matched with a closing )")
+		}
+		if err := flush(i); err != nil {
+			return "", err
+		}
+
+		var alts []string
+		for _, alt := range splitAlternatives(pat[i+2 : end]) {
+			expr, err := translatePattern(alt, mode, lookahead, false)
+			if err != nil {
+				return "", err
+			}
+			alts = append(alts, expr)
+		}
+		group := "(?:" + strings.Join(alts, "|") + ")"
+
+		switch pat[i] {
+		case '?':
+			sb.WriteString(group + "?")
+		case '*':
+			sb.WriteString(group + "*")
+		case '+':
+			sb.WriteString(group + "+")
+		case '@':
+			sb.WriteString(group)
+		case '!':
+			if !lookahead {
+				return "", errNegation
+			}
+			if !atEnd || end+1 < len(pat) {
+				return "", errNegationWhere
+			}
+			anyText := ".*"
+			if mode&pattern.Filenames != 0 {
+				anyText = "[^/]*"
+			}
+			// the rest of the string, provided the negated group doesn't match it
+			sb.WriteString("(?:(?!" + group + "$)" + anyText + ")")
+		}
+		i = end
+		chunkStart = end + 1
+	}
+
+	if err := flush(len(pat)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// `groupEnd` returns the index of the `)` closing the `(` at index `open`, else -1.
+func groupEnd(pat string, open int) int {
+	depth := 0
+	for i := open; i < len(pat); i++ {
+		switch pat[i] {
+		case '\\':
+			i++
+		case '[':
+			i = classEnd(pat, i)
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// `classEnd` returns the index of the `]` closing the bracket expression at `open`,
+// or `open` itself if it is unterminated and hence literal.
+func classEnd(pat string, open int) int {
+	i := open + 1
+	if i < len(pat) && (pat[i] == '!' || pat[i] == '^') {
+		i++
+	}
+	if i < len(pat) && pat[i] == ']' {
+		i++
+	}
+	for ; i < len(pat); i++ {
+		switch {
+		case pat[i] == '\\':
+			i++
+		case strings.HasPrefix(pat[i:], "[:"):
+			if end := strings.Index(pat[i+2:], ":]"); end >= 0 {
+				i += end + 3
+			}
+		case pat[i] == ']':
+			return i
+		}
+	}
+	return open
+}
+
+// `splitAlternatives` splits `a|b(c|d)|e` on top-level `|`.
+func splitAlternatives(pat string) []string {
+	var alts []string
+	depth, start := 0, 0
+	for i := 0; i < len(pat); i++ {
+		switch pat[i] {
+		case '\\':
+			i++
+		case '[':
+			i = classEnd(pat, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '|':
+			if depth == 0 {
+				alts = append(alts, pat[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(alts, pat[start:])
+}
+
+var posixClasses = strings.NewReplacer(
+	"[:alnum:]", `a-zA-Z0-9`,
+	"[:alpha:]", `a-zA-Z`,
+	"[:ascii:]", `\x00-\x7F`,
+	"[:blank:]", ` \t`,
+	"[:cntrl:]", `\x00-\x1F\x7F`,
+	"[:digit:]", `0-9`,
+	"[:graph:]", `!-~`,
+	"[:lower:]", `a-z`,
+	"[:print:]", ` -~`,
+	"[:punct:]", `!-\/:-@\[-`+"`"+`{-~`,
+	"[:space:]", `\t\n\v\f\r `,
+	"[:upper:]", `A-Z`,
+	"[:word:]", `\w`,
+	"[:xdigit:]", `0-9A-Fa-f`,
+)
+
+// `posixClassesToJs` rewrites e.g. `[[:alpha:]_]` as `[a-zA-Z_]`, because JS has no POSIX classes.
+func posixClassesToJs(expr string) string {
+	return posixClasses.Replace(expr)
+}
@@ -0,0 +1,112 @@
+package processor
+
+import (
+	"io/fs"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"mvdan.cc/sh/v3/pattern"
+)
+
+func TestGlob(t *testing.T) {
+	fsys := fstest.MapFS{
+		"home/a.sh":      {},
+		"home/b.sh":      {},
+		"home/.c.sh":     {},
+		"home/d/e.sh":    {},
+		"home/d/f/g.sh":  {},
+		"home/notes.txt": {},
+	}
+	readDir := func(dir string) ([]fs.DirEntry, error) {
+		if dir = strings.Trim(dir, "/"); dir == "" {
+			dir = "."
+		}
+		return fs.ReadDir(fsys, dir)
+	}
+	tests := []struct {
+		text   string
+		opts   GlobOptions
+		vars   map[string]string
+		fields []string
+	}{
+		{text: "/home/*.sh", fields: []string{"/home/a.sh", "/home/b.sh"}},
+		{text: "*.sh", opts: GlobOptions{Cwd: "/home"}, fields: []string{"a.sh", "b.sh"}},
+		{text: "/home/*.sh", opts: GlobOptions{DotGlob: true}, fields: []string{"/home/.c.sh", "/home/a.sh", "/home/b.sh"}},
+		{text: "/home/**/*.sh", opts: GlobOptions{GlobStar: true}, fields: []string{"/home/a.sh", "/home/b.sh", "/home/d/e.sh", "/home/d/f/g.sh"}},
+		{text: "/home/**", opts: GlobOptions{GlobStar: true}, fields: []string{"/home", "/home/a.sh", "/home/b.sh", "/home/d", "/home/d/e.sh", "/home/d/f", "/home/d/f/g.sh", "/home/notes.txt"}},
+		{text: "/home/d/**/", opts: GlobOptions{GlobStar: true}, fields: []string{"/home/d/", "/home/d/f/"}},
+		{text: "/home/!(*.sh)", opts: GlobOptions{ExtGlob: true}, fields: []string{"/home/d", "/home/notes.txt"}},
+		{text: "/home/*.md", fields: []string{"/home/*.md"}},
+		{text: "/home/*.md", opts: GlobOptions{NullGlob: true}, fields: []string{}},
+		{text: `"/home/*.sh"`, fields: []string{"/home/*.sh"}},
+		// the default is only expanded if used, as with `Expand`
+		{text: `${x:-$((1/0))}/*.sh`, vars: map[string]string{"x": "/home"}, fields: []string{"/home/a.sh", "/home/b.sh"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			result := Glob(tt.text, tt.opts, EvalHost{Env: testEnv(tt.vars), ReadDir: readDir})
+			if result.Error != nil {
+				t.Fatalf("unexpected error %+v", result.Error)
+			}
+			if !slices.Equal(result.Fields, tt.fields) {
+				t.Errorf("fields = %q, want %q", result.Fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestPatternRegexp(t *testing.T) {
+	tests := []struct {
+		pat    string
+		mode   pattern.Mode
+		regexp string
+		err    bool
+	}{
+		{pat: "*.!(sh)", mode: pattern.EntireString | ExtGlob, regexp: `^.*\.(?:(?!(?:sh)$).*)$`},
+		{pat: "!(a)", mode: pattern.EntireString | ExtGlob | pattern.Filenames, regexp: `^(?:(?!(?:a)$)[^/]*)$`},
+		// `!(a)*` matches `a`, which a lookahead can't express
+		{pat: "!(a)*", mode: pattern.EntireString | ExtGlob, err: true},
+		{pat: "@(!(a)|b)", mode: pattern.EntireString | ExtGlob, err: true},
+		{pat: "!(a)", mode: ExtGlob, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pat, func(t *testing.T) {
+			expr, _, err := PatternRegexp(tt.pat, tt.mode, true)
+			if tt.err {
+				if err == nil {
+					t.Errorf("got %q, want an error", expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if expr != tt.regexp {
+				t.Errorf("regexp = %q, want %q", expr, tt.regexp)
+			}
+		})
+	}
+}
+
+func TestCompileGlobNegation(t *testing.T) {
+	tests := []struct {
+		pat, text string
+		match     bool
+	}{
+		{pat: "!(a)*", text: "a", match: true},
+		{pat: "!(a)", text: "a", match: false},
+		{pat: "*.!(sh)", text: "x.sh", match: false},
+		{pat: "*.!(sh)", text: "x.txt", match: true},
+	}
+	for _, tt := range tests {
+		match, err := compileGlob(tt.pat, pattern.EntireString|ExtGlob)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.pat, err)
+		}
+		if match(tt.text) != tt.match {
+			t.Errorf("%q matching %q = %v, want %v", tt.pat, tt.text, !tt.match, tt.match)
+		}
+	}
+}
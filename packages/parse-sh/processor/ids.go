@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"reflect"
+)
+
+// `numberNodes` sets the `ID` of each node of a mapped file to its pre-order index, i.e. the
+// file is 0 and children follow their parent in field order, along with the `ParentID`,
+// which is -1 for the file. IDs only depend on the text, so they survive copying the tree.
+func numberNodes(file *File) {
+	next := 0
+	walkNodes(reflect.ValueOf(file).Elem(), -1, func(node reflect.Value, parent int) int {
+		id := next
+		next++
+		node.FieldByName("ID").SetInt(int64(id))
+		node.FieldByName("ParentID").SetInt(int64(parent))
+		return id
+	})
+}
+
+// `NodeTable` lists the nodes of a file mapped by `MapFile`, indexed by `ID`, so nodes can be
+// referred to without the tree e.g. by breakpoints, coverage or caches.
+func NodeTable(file *File) []NodeInfo {
+	table := []NodeInfo{}
+	walkNodes(reflect.ValueOf(file).Elem(), -1, func(node reflect.Value, parent int) int {
+		info := NodeInfo{
+			ID:       int(node.FieldByName("ID").Int()),
+			ParentID: parent,
+			Type:     node.Type().Name(),
+		}
+		if typ := node.FieldByName("Type"); typ.IsValid() {
+			info.Type = typ.String()
+		}
+		// e.g. `Expansion` lacks a range
+		if pos := node.FieldByName("Pos"); pos.IsValid() {
+			start, end := pos.Interface().(Pos), node.FieldByName("End").Interface().(Pos)
+			info.Pos, info.End = &start, &end
+		}
+		table = append(table, info)
+		return info.ID
+	})
+	return table
+}
+
+// `walkNodes` visits the nodes below `v` in pre-order, where `visit` returns the ID
+// of a node i.e. the parent of its children. Nodes are the structs with an `ID` field.
+func walkNodes(v reflect.Value, parent int, visit func(node reflect.Value, parent int) int) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			walkNodes(v.Elem(), parent, visit)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkNodes(v.Index(i), parent, visit)
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(Pos{}) {
+			return
+		}
+		if _, ok := v.Type().FieldByName("ID"); ok {
+			parent = visit(v, parent)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			walkNodes(v.Field(i), parent, visit)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNodeTable(t *testing.T) {
+	file, err := Parse("echo $x; f() { :; }", "", ParserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapped := MapFile(*file)
+	table := NodeTable(&mapped)
+
+	types := []string{}
+	for i, info := range table {
+		if info.ID != i {
+			t.Errorf("node %d has ID %d", i, info.ID)
+		}
+		if info.ParentID >= info.ID {
+			t.Errorf("node %d follows its parent %d", info.ID, info.ParentID)
+		}
+		types = append(types, info.Type)
+	}
+	want := []string{
+		"File",
+		"Stmt", "CallExpr", "Word", "Lit", "Word", "ParamExp", "Lit",
+		"Stmt", "FuncDecl", "Lit", "Stmt", "Block", "Stmt", "CallExpr", "Word", "Lit",
+	}
+	if !slices.Equal(types, want) {
+		t.Errorf("types = %q, want %q", types, want)
+	}
+	if table[0].ParentID != -1 || table[1].ParentID != 0 || table[2].ParentID != 1 {
+		t.Errorf("parents = %d %d %d, want -1 0 1", table[0].ParentID, table[1].ParentID, table[2].ParentID)
+	}
+
+	// IDs only depend on the text
+	again, _ := Parse("echo $x; f() { :; }", "", ParserOptions{})
+	remapped := MapFile(*again)
+	if !slices.EqualFunc(NodeTable(&remapped), table, func(a, b NodeInfo) bool {
+		return a.ID == b.ID && a.ParentID == b.ParentID && a.Type == b.Type && (a.Pos == nil) == (b.Pos == nil) && (a.Pos == nil || *a.Pos == *b.Pos)
+	}) {
+		t.Error("IDs differ between parses of the same text")
+	}
+}
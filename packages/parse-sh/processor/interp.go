@@ -0,0 +1,346 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"mvdan.cc/sh/v3/expand"
+	"mvdan.cc/sh/v3/interp"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `RunHost` provides what `interp` cannot do inside wasm, e.g. via the JS session.
+// Nil callbacks fall back to `interp`'s defaults, which use the OS.
+type RunHost struct {
+	Env *HostEnviron
+	// `Exec` runs a command which is neither a function nor a bash builtin e.g. `run` or `npc`,
+	// returning its exit status and output.
+	Exec func(args []string, dir string) (status int, stdout string, stderr string, err error)
+	// `ReadFile` provides the contents of a file opened for reading e.g. `< file` or `source file`.
+	ReadFile func(path string) (string, error)
+	// `WriteFile` receives what was written to a file once it is closed e.g. `> file`.
+	WriteFile func(path string, data string, append bool) error
+	Stat      func(path string) (fs.FileInfo, error)
+	ReadDir   func(path string) ([]fs.DirEntry, error)
+}
+
+// `Runner` runs a parsed file through `interp.Runner` one top-level statement at a time,
+// so behaviour can be compared with the JS interpreter.
+//
+// Pipelines, heredocs, here-strings and process substitutions need `os.Pipe` or a FIFO,
+// which wasm lacks, so `NewRunner` rejects them up front.
+type Runner struct {
+	file   *syntax.File
+	runner *interp.Runner
+	host   RunHost
+	next   int
+	done   bool
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+	// variables as last synced with the host, see `syncVars`
+	synced map[string]expand.Variable
+}
+
+// `hostCommand` prefixes the arguments of `RunOptions.Builtins`, so they reach `Exec`
+// even though bash implements them.
+const hostCommand = "\x00host"
+
+func NewRunner(file *syntax.File, host RunHost, options RunOptions) (*Runner, error) {
+	if err := checkRunnable(file); err != nil {
+		return nil, err
+	}
+	if host.Env == nil {
+		host.Env = &HostEnviron{}
+	}
+	r := &Runner{file: file, host: host}
+
+	opts := []interp.RunnerOption{
+		interp.Env(host.Env),
+		interp.StdIO(nil, &r.stdout, &r.stderr),
+		interp.ExecHandler(r.exec),
+	}
+	if options.Dir != "" {
+		// `interp.Dir` would stat the directory
+		opts = append(opts, func(runner *interp.Runner) error {
+			runner.Dir = options.Dir
+			return nil
+		})
+	}
+	if len(options.Params) > 0 {
+		opts = append(opts, interp.Params(append([]string{"--"}, options.Params...)...))
+	}
+	if len(options.Builtins) > 0 {
+		opts = append(opts, interp.CallHandler(func(ctx context.Context, args []string) ([]string, error) {
+			if slices.Contains(options.Builtins, args[0]) && r.runner.Funcs[args[0]] == nil {
+				return append([]string{hostCommand}, args...), nil
+			}
+			return args, nil
+		}))
+	}
+	if host.ReadFile != nil || host.WriteFile != nil {
+		opts = append(opts, interp.OpenHandler(r.open))
+	}
+	if host.Stat != nil {
+		opts = append(opts, interp.StatHandler(func(ctx context.Context, name string, followSymlinks bool) (fs.FileInfo, error) {
+			return host.Stat(name)
+		}))
+	}
+	if host.ReadDir != nil {
+		opts = append(opts, interp.ReadDirHandler2(func(ctx context.Context, path string) ([]fs.DirEntry, error) {
+			return host.ReadDir(path)
+		}))
+	}
+
+	runner, err := interp.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	r.runner = runner
+
+	// a no-op run, so variables `interp` initialises e.g. `IFS` are not synced to the host
+	runner.Run(context.Background(), &syntax.CallExpr{})
+	r.synced = maps.Clone(runner.Vars)
+	return r, nil
+}
+
+// `checkRunnable` reports the first construct `interp` cannot run inside wasm.
+func checkRunnable(file *syntax.File) error {
+	var err error
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if err != nil {
+			return false
+		}
+		construct := ""
+		switch node := node.(type) {
+		case *syntax.BinaryCmd:
+			if node.Op == syntax.Pipe || node.Op == syntax.PipeAll {
+				construct = "pipeline"
+			}
+		case *syntax.Redirect:
+			switch node.Op {
+			case syntax.Hdoc, syntax.DashHdoc:
+				construct = "heredoc"
+			case syntax.WordHdoc:
+				construct = "here-string"
+			}
+		case *syntax.ProcSubst:
+			construct = "process substitution"
+		}
+		if construct != "" {
+			err = fmt.Errorf("%s: %s is not supported, as wasm lacks pipes", node.Pos(), construct)
+		}
+		return true
+	})
+	return err
+}
+
+// `Step` runs at most `count` top-level statements, or all remaining if `count` is not positive,
+// stopping early on `exit` or a fatal error.
+func (r *Runner) Step(count int) RunResult {
+	r.host.Env.Assigns = nil
+	r.stdout.Reset()
+	r.stderr.Reset()
+	result := RunResult{}
+
+	ctx := context.Background()
+	for ran := 0; !r.done && (count <= 0 || ran < count); ran++ {
+		if r.next >= len(r.file.Stmts) {
+			r.done = true
+			break
+		}
+		// `Run` only adds to `Vars`, so clear it to see unset variables
+		clear(r.runner.Vars)
+		err := r.runner.Run(ctx, r.file.Stmts[r.next])
+		r.next++
+		if syncErr := r.syncVars(); syncErr != nil && err == nil {
+			err = syncErr
+		}
+
+		result.Status = 0
+		if status, ok := interp.IsExitStatus(err); ok {
+			result.Status = int(status)
+		} else if err != nil {
+			result.Error = err.Error()
+			r.done = true
+		}
+		if r.runner.Exited() {
+			result.Exited = true
+			r.done = true
+		}
+	}
+
+	next := r.Peek()
+	result.Next, result.Done, result.Pos, result.End = next.Next, next.Done, next.Pos, next.End
+	result.Stdout, result.Stderr = r.stdout.String(), r.stderr.String()
+	result.Assigns = r.host.Env.Assigns
+	if result.Assigns == nil {
+		result.Assigns = []VarAssign{}
+	}
+	return result
+}
+
+// `Peek` describes the next statement without running it.
+func (r *Runner) Peek() RunResult {
+	if r.next >= len(r.file.Stmts) {
+		r.done = true
+	}
+	result := RunResult{Next: r.next, Done: r.done, Assigns: []VarAssign{}}
+	if !r.done {
+		stmt := r.file.Stmts[r.next]
+		result.Pos, result.End = mapNullablePos(stmt.Pos()), mapNullablePos(stmt.End())
+	}
+	return result
+}
+
+// `syncVars` writes global variables changed by the interpreter to the host,
+// including those unset, stopping at the first the host rejects.
+// Variables assigned by the script shadow the host's until the runner is discarded.
+func (r *Runner) syncVars() error {
+	names := slices.Sorted(maps.Keys(r.runner.Vars))
+	for _, name := range slices.Sorted(maps.Keys(r.synced)) {
+		if _, ok := r.runner.Vars[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		vr := r.runner.Vars[name]
+		if vr.Local || variablesEqual(vr, r.synced[name]) {
+			continue
+		}
+		if err := r.host.Env.Set(name, vr); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if vr.IsSet() {
+			r.synced[name] = vr
+		} else {
+			delete(r.synced, name)
+		}
+	}
+	return nil
+}
+
+func variablesEqual(a, b expand.Variable) bool {
+	return a.IsSet() == b.IsSet() && a.Kind == b.Kind && a.Str == b.Str &&
+		a.Exported == b.Exported && a.ReadOnly == b.ReadOnly &&
+		slices.Equal(a.List, b.List) && mapsEqual(a.Map, b.Map)
+}
+
+func mapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Runner) exec(ctx context.Context, args []string) error {
+	hc := interp.HandlerCtx(ctx)
+	if args[0] == hostCommand {
+		args = args[1:]
+	}
+	if r.host.Exec == nil {
+		io.WriteString(hc.Stderr, args[0]+": command not found\n")
+		return interp.NewExitStatus(127)
+	}
+	status, stdout, stderr, err := r.host.Exec(args, hc.Dir)
+	if err != nil {
+		return err
+	}
+	io.WriteString(hc.Stdout, stdout)
+	io.WriteString(hc.Stderr, stderr)
+	return interp.NewExitStatus(uint8(status))
+}
+
+func (r *Runner) open(ctx context.Context, name string, flag int, perm os.FileMode) (io.ReadWriteCloser, error) {
+	if name == "/dev/null" {
+		return devNull{}, nil
+	}
+	if !path.IsAbs(name) {
+		name = path.Join(interp.HandlerCtx(ctx).Dir, name)
+	}
+	file := &hostFile{path: name, host: r.host, append: flag&os.O_APPEND != 0}
+	if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+		if r.host.WriteFile == nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: errors.ErrUnsupported}
+		}
+		file.write = true
+	}
+	if flag&os.O_WRONLY == 0 {
+		if r.host.ReadFile == nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: errors.ErrUnsupported}
+		}
+		data, err := r.host.ReadFile(name)
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+		file.reader = strings.NewReader(data)
+	}
+	return file, nil
+}
+
+// `hostFile` is a file of the host's virtual filesystem, written back on close.
+type hostFile struct {
+	path   string
+	host   RunHost
+	reader *strings.Reader
+	data   bytes.Buffer
+	write  bool
+	append bool
+}
+
+func (f *hostFile) Read(p []byte) (int, error) {
+	if f.reader == nil {
+		return 0, io.EOF
+	}
+	return f.reader.Read(p)
+}
+
+func (f *hostFile) Write(p []byte) (int, error) {
+	return f.data.Write(p)
+}
+
+func (f *hostFile) Close() error {
+	if !f.write {
+		return nil
+	}
+	return f.host.WriteFile(f.path, f.data.String(), f.append)
+}
+
+type devNull struct{}
+
+func (devNull) Read(p []byte) (int, error)  { return 0, io.EOF }
+func (devNull) Write(p []byte) (int, error) { return len(p), nil }
+func (devNull) Close() error                { return nil }
+
+// `HostFileInfo` describes a file of the host's virtual filesystem.
+type HostFileInfo struct {
+	FileName string
+	Dir      bool
+	FileSize int64
+}
+
+func (i HostFileInfo) Name() string { return i.FileName }
+func (i HostFileInfo) Size() int64  { return i.FileSize }
+func (i HostFileInfo) Mode() fs.FileMode {
+	if i.Dir {
+		return fs.ModeDir | 0o755
+	}
+	return 0o644
+}
+func (i HostFileInfo) ModTime() time.Time { return time.Time{} }
+func (i HostFileInfo) IsDir() bool        { return i.Dir }
+func (i HostFileInfo) Sys() any           { return nil }
@@ -0,0 +1,70 @@
+package processor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"mvdan.cc/sh/v3/expand"
+)
+
+func TestRunnerUnsupported(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"echo a | cat", "pipeline"},
+		{"cat <<EOF\na\nEOF", "heredoc"},
+		{"cat <<< a", "here-string"},
+		{"cat <(echo a)", "process substitution"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = NewRunner(file, RunHost{}, RunOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunnerSyncVars(t *testing.T) {
+	host := map[string]expand.Variable{}
+	env := &HostEnviron{
+		Lookup: func(name string) expand.Variable { return host[name] },
+		Assign: func(name string, vr expand.Variable) error {
+			if name == "locked" {
+				return errors.New("rejected")
+			}
+			host[name] = vr
+			return nil
+		},
+	}
+	file, err := Parse("x=1 y=2\nunset x\nlocked=1\necho unreachable", "", ParserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner, err := NewRunner(file, RunHost{Env: env}, RunOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := runner.Step(1); result.Error != "" || host["x"].Str != "1" || host["y"].Str != "2" {
+		t.Fatalf("after assigning: %+v, host %v", result, host)
+	}
+	result := runner.Step(1)
+	if result.Error != "" || host["x"].IsSet() || !host["y"].IsSet() {
+		t.Fatalf("after unset: %+v, host %v", result, host)
+	}
+	if len(result.Assigns) != 1 || result.Assigns[0].Name != "x" || !result.Assigns[0].Unset {
+		t.Errorf("assigns = %+v, want x unset", result.Assigns)
+	}
+	result = runner.Step(0)
+	if !strings.Contains(result.Error, "rejected") || !result.Done || result.Stdout != "" {
+		t.Errorf("after rejected assign: %+v", result)
+	}
+}
@@ -0,0 +1,175 @@
+package processor
+
+import (
+	"strconv"
+	"time"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+func (e *LimitError) Error() string {
+	return e.Message
+}
+
+func limitError(kind string, limit int, what string) *LimitError {
+	return &LimitError{
+		Kind:    kind,
+		Limit:   limit,
+		Message: what + " exceeds the limit of " + strconv.Itoa(limit),
+	}
+}
+
+// `checkInput` runs before parsing, since deep nesting could overflow the parser's stack.
+func checkInput(text string, limits ParseLimits) *LimitError {
+	if limits.MaxInputBytes > 0 && len(text) > limits.MaxInputBytes {
+		return limitError("InputBytes", limits.MaxInputBytes, "input of "+strconv.Itoa(len(text))+" bytes")
+	}
+	if limits.MaxDepth > 0 && textDepth(text) > limits.MaxDepth {
+		return limitError("Depth", limits.MaxDepth, "nesting")
+	}
+	return nil
+}
+
+// `textDepth` estimates nesting from brackets outside single quotes, escapes and comments.
+// Every bracket nests at least one node e.g. `$(`, `${`, `(( … ))` or `{ …; }`.
+// Within double quotes `'` is literal and only brackets after `$` nest, which unquotes
+// until the bracket closes e.g. `"$( echo "it's" )"`.
+func textDepth(text string) int {
+	depth, deepest := 0, 0
+	quoted := false
+	// whether each open bracket was within double quotes
+	stack := []bool{}
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; c {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case '\'':
+			if quoted {
+				continue
+			}
+			if i > 0 && text[i-1] == '$' {
+				// `$'…'` supports escapes
+				for i++; i < len(text) && text[i] != '\''; i++ {
+					if text[i] == '\\' {
+						i++
+					}
+				}
+				continue
+			}
+			for i++; i < len(text) && text[i] != '\''; i++ {
+			}
+		case '#':
+			if !quoted && (i == 0 || text[i-1] == ' ' || text[i-1] == '\t' || text[i-1] == '\n') {
+				for ; i < len(text) && text[i] != '\n'; i++ {
+				}
+			}
+		case '(', '{', '[':
+			if quoted && (i == 0 || text[i-1] != '$') {
+				continue
+			}
+			stack = append(stack, quoted)
+			quoted = false
+			depth++
+			deepest = max(deepest, depth)
+		case ')', '}', ']':
+			if quoted {
+				continue
+			}
+			// unbalanced e.g. `a)` of a case item
+			if len(stack) > 0 {
+				quoted = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+			depth = max(depth-1, 0)
+		}
+	}
+	return deepest
+}
+
+// `checkFile` bounds the number of nodes and their nesting, before mapping.
+func checkFile(file *syntax.File, limits ParseLimits) *LimitError {
+	if limits.MaxNodes <= 0 && limits.MaxDepth <= 0 {
+		return nil
+	}
+	var err *LimitError
+	nodes, depth := 0, 0
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			depth--
+			return true
+		}
+		if err != nil {
+			return false
+		}
+		nodes++
+		depth++
+		switch {
+		case limits.MaxNodes > 0 && nodes > limits.MaxNodes:
+			err = limitError("Nodes", limits.MaxNodes, "number of nodes")
+		case limits.MaxDepth > 0 && depth > limits.MaxDepth:
+			err = limitError("Depth", limits.MaxDepth, "nesting")
+		}
+		// children are not visited, so there is no matching nil
+		if err != nil {
+			depth--
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// `mapBudget` is checked while mapping, if `MapFileLimited` set it.
+var mapBudget *budget
+
+type budget struct {
+	limits   ParseLimits
+	steps    int
+	deadline time.Time
+	err      *LimitError
+}
+
+// `spend` is called per mapped node, recording when the budget is exceeded.
+func spend() {
+	b := mapBudget
+	if b == nil || b.err != nil {
+		return
+	}
+	b.steps++
+	if b.limits.MaxSteps > 0 && b.steps > b.limits.MaxSteps {
+		b.err = limitError("Steps", b.limits.MaxSteps, "mapping steps")
+	}
+	// reading the clock is relatively expensive
+	if b.limits.MaxMillis > 0 && b.steps%256 == 0 && time.Now().After(b.deadline) {
+		b.err = limitError("Time", b.limits.MaxMillis, "mapping time in milliseconds")
+	}
+}
+
+// `budgetExceeded` stops mapping lists early, so mapping finishes soon after the budget is exceeded.
+func budgetExceeded() bool {
+	return mapBudget != nil && mapBudget.err != nil
+}
+
+// `MapFileLimited` is `MapFile` with a step and time budget.
+func MapFileLimited(file syntax.File, limits ParseLimits) (File, *LimitError) {
+	var result File
+	if err := withBudget(limits, func() { result = MapFile(file) }); err != nil {
+		return File{Type: "File", Name: file.Name, Stmts: []Stmt{}, Last: []Comment{}}, err
+	}
+	return result, nil
+}
+
+// `withBudget` runs `mapping` with the step and time budget of `limits`, if any.
+func withBudget(limits ParseLimits, mapping func()) *LimitError {
+	if limits.MaxSteps <= 0 && limits.MaxMillis <= 0 {
+		mapping()
+		return nil
+	}
+	mapBudget = &budget{limits: limits, deadline: time.Now().Add(time.Duration(limits.MaxMillis) * time.Millisecond)}
+	defer func() { mapBudget = nil }()
+
+	mapping()
+	return mapBudget.err
+}
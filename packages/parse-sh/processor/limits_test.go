@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextDepth(t *testing.T) {
+	tests := []struct {
+		text  string
+		depth int
+	}{
+		{"echo x", 0},
+		{"echo $(a $(b $(c)))", 3},
+		{"echo ${x:-${y}}", 2},
+		{"(( 1 + (2) ))", 3},
+		{"echo '$( $( $( x ) ) )'", 0},
+		{"echo \"it's\" $( $( $( x ) ) )", 3},
+		{"echo \"it's $( $( $( x ) ) )\"", 3},
+		{"echo \"(not) {nested}\"", 0},
+		{"echo \"$( echo \"it's\" ) $(x)\" $( $(y) )", 2},
+		{"echo \"$(( 1 + 2 ))\"", 2},
+		{"echo \\' $( $(x) )", 2},
+		{"echo $'it\\'s' $( $(x) )", 2},
+		{"# it's $( $( x ) )\necho $(x)", 1},
+		{"echo a#b $(x)", 1},
+		{"case x in a) $( $(x) ) ;; esac", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if depth := textDepth(tt.text); depth != tt.depth {
+				t.Errorf("textDepth = %d, want %d", depth, tt.depth)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	deep := "echo \"it's\" " + strings.Repeat("$( ", 50) + "x" + strings.Repeat(" )", 50)
+	tests := []struct {
+		name   string
+		text   string
+		limits ParseLimits
+		kind   string
+	}{
+		{"input bytes", "echo hello", ParseLimits{MaxInputBytes: 5}, "InputBytes"},
+		{"depth", deep, ParseLimits{MaxDepth: 20}, "Depth"},
+		{"depth within limit", "echo $(x)", ParseLimits{MaxDepth: 20}, ""},
+		{"nodes", "a; b; c; d", ParseLimits{MaxNodes: 5}, "Nodes"},
+		{"steps", "a; b; c; d", ParseLimits{MaxSteps: 3}, "Steps"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{Limits: tt.limits})
+			kind := ""
+			if limitErr, ok := err.(*LimitError); ok {
+				kind = limitErr.Kind
+			} else if err != nil {
+				t.Fatal(err)
+			} else if _, limitErr := MapFileLimited(*file, tt.limits); limitErr != nil {
+				kind = limitErr.Kind
+			}
+			if kind != tt.kind {
+				t.Errorf("limit = %q, want %q", kind, tt.kind)
+			}
+		})
+	}
+}
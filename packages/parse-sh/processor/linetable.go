@@ -0,0 +1,209 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+type lineTabler struct {
+	// node IDs of `MapFile` by type and range, in pre-order
+	ids map[nodeKey][]int
+	// statements deciding a branch of `if`, `elif`, `while` or `until`
+	conditions map[*syntax.Stmt]bool
+	// `elif` and `else` clauses, which belong to the branch of their `if`
+	elses     map[*syntax.IfClause]bool
+	functions []string
+	result    LineTableResult
+}
+
+type nodeKey struct {
+	typ      string
+	pos, end uint
+}
+
+// `LineTable` lists the executable statements of `file` in source order, e.g. for breakpoints,
+// stepping or coverage, along with the branches between them:
+//   - simple commands, including declarations, `let`, `(( ))`, `[[ ]]` and bare redirections
+//   - conditions of `if`, `elif`, `while` and `until`, including both sides of `&&` and `||`
+//   - `for` and `select` headers, ranging until `do`
+//   - case arms, ranging over their patterns
+//
+// Compound commands are not listed, but their statements are. Branches are `if` chains,
+// `case` and `&&` or `||`, where an arm is taken once any of its statements runs.
+//
+// IDs are those of `MapFile` given `mapped`, or -1 for nodes it omits e.g. within `<(…)`.
+func LineTable(file *syntax.File, mapped *File) LineTableResult {
+	t := &lineTabler{
+		ids:        map[nodeKey][]int{},
+		conditions: map[*syntax.Stmt]bool{},
+		elses:      map[*syntax.IfClause]bool{},
+		result:     LineTableResult{Entries: []LineEntry{}, Branches: []Branch{}},
+	}
+	for _, info := range NodeTable(mapped) {
+		if info.Pos != nil {
+			key := nodeKey{info.Type, info.Pos.Offset, info.End.Offset}
+			t.ids[key] = append(t.ids[key], info.ID)
+		}
+	}
+
+	stack := []syntax.Node{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			if _, ok := stack[len(stack)-1].(*syntax.FuncDecl); ok {
+				t.functions = t.functions[:len(t.functions)-1]
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, node)
+		t.visit(node)
+		return true
+	})
+	return t.result
+}
+
+func (t *lineTabler) visit(node syntax.Node) {
+	switch node := node.(type) {
+	case *syntax.FuncDecl:
+		t.functions = append(t.functions, node.Name.Value)
+	case *syntax.Stmt:
+		switch cmd := node.Cmd.(type) {
+		case nil, *syntax.CallExpr, *syntax.DeclClause, *syntax.LetClause, *syntax.ArithmCmd, *syntax.TestClause:
+			kind := "command"
+			if t.conditions[node] {
+				kind = "condition"
+			}
+			typ := ""
+			if cmd != nil {
+				typ = nodeTypeName(cmd)
+			}
+			t.entry(node, kind, typ, node.Pos(), node.End())
+		case *syntax.ForClause:
+			t.entry(node, "loop", "ForClause", node.Pos(), cmd.Loop.End())
+		}
+	case *syntax.CaseItem:
+		t.entry(node, "case-arm", "CaseItem", node.Pos(), node.Patterns[len(node.Patterns)-1].End())
+	case *syntax.IfClause:
+		t.markConditions(node.Cond)
+		if !t.elses[node] {
+			t.ifBranch(node)
+		}
+	case *syntax.WhileClause:
+		t.markConditions(node.Cond)
+	case *syntax.CaseClause:
+		t.caseBranch(node)
+	case *syntax.BinaryCmd:
+		if node.Op == syntax.AndStmt || node.Op == syntax.OrStmt {
+			t.branch(node, node.Op.String(), []BranchArm{
+				t.arm("left", []*syntax.Stmt{node.X}),
+				t.arm("right", []*syntax.Stmt{node.Y}),
+			})
+		}
+	}
+}
+
+// `markConditions` marks the statements whose status decides a branch,
+// i.e. through `&&`, `||` and `!`, but not within e.g. `{ …; }`.
+func (t *lineTabler) markConditions(stmts []*syntax.Stmt) {
+	for _, stmt := range stmts {
+		t.conditions[stmt] = true
+		if cmd, ok := stmt.Cmd.(*syntax.BinaryCmd); ok && (cmd.Op == syntax.AndStmt || cmd.Op == syntax.OrStmt) {
+			t.markConditions([]*syntax.Stmt{cmd.X, cmd.Y})
+		}
+	}
+}
+
+// `ifBranch` adds an `if` along with its `elif` and `else` arms, where a missing `else` is implicit.
+func (t *lineTabler) ifBranch(node *syntax.IfClause) {
+	arms := []BranchArm{}
+	for clause := node; clause != nil; clause = clause.Else {
+		switch {
+		case len(clause.Cond) == 0:
+			arms = append(arms, t.arm("else", clause.Then))
+		case clause == node:
+			arms = append(arms, t.arm("then", clause.Then))
+		default:
+			arms = append(arms, t.arm("elif", clause.Then))
+		}
+		if clause.Else != nil {
+			t.elses[clause.Else] = true
+		}
+		if clause.Else == nil && len(clause.Cond) > 0 {
+			arms = append(arms, BranchArm{Label: "else", ID: -1, Stmts: []int{}, Implicit: true})
+		}
+	}
+	t.branch(node, "if", arms)
+}
+
+// `caseBranch` adds a `case` with an arm per item, where no item matching is implicit unless some item is `*`.
+func (t *lineTabler) caseBranch(node *syntax.CaseClause) {
+	arms := []BranchArm{}
+	catchAll := false
+	for _, item := range node.Items {
+		patterns := make([]string, len(item.Patterns))
+		for i, pattern := range item.Patterns {
+			patterns[i] = wordText(pattern)
+			catchAll = catchAll || patterns[i] == "*"
+		}
+		arm := t.arm(strings.Join(patterns, " | "), item.Stmts)
+		arm.ID = t.id(item)
+		arms = append(arms, arm)
+	}
+	if !catchAll {
+		arms = append(arms, BranchArm{Label: "*", ID: -1, Stmts: []int{}, Implicit: true})
+	}
+	t.branch(node, "case", arms)
+}
+
+func (t *lineTabler) arm(label string, stmts []*syntax.Stmt) BranchArm {
+	arm := BranchArm{Label: label, ID: -1, Stmts: make([]int, len(stmts))}
+	for i, stmt := range stmts {
+		arm.Stmts[i] = t.id(stmt)
+	}
+	if len(stmts) > 0 {
+		arm.Pos, arm.End = mapNullablePos(stmts[0].Pos()), mapNullablePos(stmts[len(stmts)-1].End())
+	}
+	return arm
+}
+
+func (t *lineTabler) branch(node syntax.Node, kind string, arms []BranchArm) {
+	t.result.Branches = append(t.result.Branches, Branch{
+		ID:       t.id(node),
+		Kind:     kind,
+		Function: t.function(),
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+		Arms:     arms,
+	})
+}
+
+func (t *lineTabler) entry(node syntax.Node, kind string, typ string, pos, end syntax.Pos) {
+	t.result.Entries = append(t.result.Entries, LineEntry{
+		ID:       t.id(node),
+		Kind:     kind,
+		Type:     typ,
+		Function: t.function(),
+		Pos:      mapPos(pos),
+		End:      mapPos(end),
+	})
+}
+
+// `id` is the ID of `node` in the mapped file, or the first of nodes
+// with the same type and range, which only recovered nodes share.
+func (t *lineTabler) id(node syntax.Node) int {
+	key := nodeKey{nodeTypeName(node), node.Pos().Offset(), node.End().Offset()}
+	ids := t.ids[key]
+	if len(ids) == 0 {
+		return -1
+	}
+	return ids[0]
+}
+
+// `function` is the innermost enclosing function, else "".
+func (t *lineTabler) function() string {
+	if len(t.functions) == 0 {
+		return ""
+	}
+	return t.functions[len(t.functions)-1]
+}
@@ -0,0 +1,63 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLineTableBranches(t *testing.T) {
+	tests := []struct {
+		text string
+		// kind and arm labels of each branch
+		want [][]string
+	}{
+		{"if a; then b; fi", [][]string{{"if", "then", "else"}}},
+		{"if a; then b; else c; fi", [][]string{{"if", "then", "else"}}},
+		{"if a && b; then c; elif d; then e; else g; fi", [][]string{{"if", "then", "elif", "else"}, {"&&", "left", "right"}}},
+		{"case $x in a) b ;; esac", [][]string{{"case", "a", "*"}}},
+		{"case $x in a|*) b ;; esac", [][]string{{"case", "a | *"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			mapped := MapFile(*file)
+			result := LineTable(file, &mapped)
+			got := [][]string{}
+			for _, branch := range result.Branches {
+				labels := []string{branch.Kind}
+				for _, arm := range branch.Arms {
+					labels = append(labels, arm.Label)
+				}
+				got = append(got, labels)
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("branches = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineTableEntries(t *testing.T) {
+	text := "f() {\n  if a; then b; fi\n}\nfor i in 1; do c; done"
+	file, err := Parse(text, "", ParserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapped := MapFile(*file)
+	result := LineTable(file, &mapped)
+	type entry struct{ kind, function string }
+	want := []entry{{"condition", "f"}, {"command", "f"}, {"loop", ""}, {"command", ""}}
+	got := []entry{}
+	for _, e := range result.Entries {
+		if e.ID < 0 {
+			t.Errorf("entry %+v has no ID", e)
+		}
+		got = append(got, entry{e.Kind, e.Function})
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("entries = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,598 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	SeverityStyle   = "style"
+)
+
+// `LintRule` is a check run on every node of the tree, in pre-order.
+type LintRule struct {
+	ID       string
+	Severity string
+	Summary  string
+	Fixable  bool
+	check    func(l *linter, node syntax.Node)
+}
+
+// `lintRules` is the registry, where rules needing `LintOptions.Modules` are skipped without it.
+var lintRules = []*LintRule{
+	{
+		ID:       "quote-expansion",
+		Severity: SeverityWarning,
+		Summary:  "Unquoted `$var` or `$( cmd )` is split on whitespace",
+		Fixable:  true,
+		check:    checkQuoteExpansion,
+	},
+	{
+		ID:       "cd-unchecked",
+		Severity: SeverityWarning,
+		Summary:  "`cd` may fail, so handle it e.g. `cd foo || return`",
+		Fixable:  true,
+		check:    checkCdUnchecked,
+	},
+	{
+		ID:       "unused-variable",
+		Severity: SeverityWarning,
+		Summary:  "Variable is assigned but never used; uppercase names are treated as session environment",
+		check:    checkUnusedVariable,
+	},
+	{
+		ID:       "exit-status-check",
+		Severity: SeverityStyle,
+		Summary:  "Check a command directly e.g. `if cmd; then`, rather than via `$?`",
+		check:    checkExitStatus,
+	},
+	{
+		ID:       "assign-spaces",
+		Severity: SeverityError,
+		Summary:  "Assignments cannot have spaces around `=`",
+		Fixable:  true,
+		check:    checkAssignSpaces,
+	},
+	{
+		ID:       "local-outside-function",
+		Severity: SeverityError,
+		Summary:  "`local` is only valid inside a function",
+		check:    checkLocalOutsideFunction,
+	},
+	{
+		ID:       "backticks",
+		Severity: SeverityStyle,
+		Summary:  "Prefer `$( cmd )` to legacy backticks",
+		Fixable:  true,
+		check:    checkBackticks,
+	},
+	{
+		ID:       "deprecated-arithm",
+		Severity: SeverityStyle,
+		Summary:  "`$[ expr ]` is deprecated, use `$(( expr ))`",
+		Fixable:  true,
+		check:    checkDeprecatedArithm,
+	},
+	{
+		ID:       "unknown-module",
+		Severity: SeverityError,
+		Summary:  "`run`, `map` or `import` refers to a module our runtime does not provide",
+		check:    checkUnknownModule,
+	},
+	{
+		ID:       "unknown-module-function",
+		Severity: SeverityError,
+		Summary:  "`run`, `map` or `import` refers to a function its module does not export",
+		check:    checkUnknownModuleFunction,
+	},
+}
+
+// `LintRules` lists the registry, for documentation and editor integration.
+func LintRules() []LintRuleInfo {
+	infos := make([]LintRuleInfo, len(lintRules))
+	for i, rule := range lintRules {
+		infos[i] = LintRuleInfo{ID: rule.ID, Severity: rule.Severity, Summary: rule.Summary, Fixable: rule.Fixable}
+	}
+	return infos
+}
+
+type linter struct {
+	opts  LintOptions
+	rule  *LintRule
+	stack []syntax.Node
+	diags []Diagnostic
+	// line to rule IDs disabled via `# lint-disable`, where line 0 means the whole file
+	disabled map[uint][]string
+	// names of the functions the file defines
+	functions map[string]bool
+}
+
+// `Lint` runs the registry over `file`, sorting diagnostics by position.
+//
+// Rules can be disabled via `LintOptions.Disable`, or comments in the source:
+// `# lint-disable rule-id` for its own and the next line, or `# lint-disable-file rule-id`.
+func Lint(file *syntax.File, opts LintOptions) []Diagnostic {
+	l := &linter{
+		opts:      opts,
+		diags:     []Diagnostic{},
+		disabled:  lintDirectives(file),
+		functions: map[string]bool{},
+	}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if fn, ok := node.(*syntax.FuncDecl); ok {
+			l.functions[fn.Name.Value] = true
+		}
+		return true
+	})
+
+	rules := []*LintRule{}
+	for _, rule := range lintRules {
+		if slices.Contains(opts.Disable, rule.ID) {
+			continue
+		}
+		if opts.Modules == nil && strings.HasPrefix(rule.ID, "unknown-module") {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			l.stack = l.stack[:len(l.stack)-1]
+			return true
+		}
+		for _, rule := range rules {
+			l.rule = rule
+			rule.check(l, node)
+		}
+		l.stack = append(l.stack, node)
+		return true
+	})
+
+	slices.SortStableFunc(l.diags, func(a, b Diagnostic) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	return l.diags
+}
+
+// `lintDirectives` collects rule IDs from comments e.g. `# lint-disable cd-unchecked,backticks`.
+func lintDirectives(file *syntax.File) map[uint][]string {
+	disabled := map[uint][]string{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		comment, ok := node.(*syntax.Comment)
+		if !ok {
+			return true
+		}
+		fields := strings.Fields(comment.Text)
+		if len(fields) < 2 {
+			return true
+		}
+		ids := strings.Split(strings.Join(fields[1:], ","), ",")
+		switch line := comment.Hash.Line(); fields[0] {
+		case "lint-disable":
+			disabled[line] = append(disabled[line], ids...)
+			disabled[line+1] = append(disabled[line+1], ids...)
+		case "lint-disable-file":
+			disabled[0] = append(disabled[0], ids...)
+		}
+		return true
+	})
+	return disabled
+}
+
+// `report` adds a diagnostic for the current rule, unless disabled at `node`'s line.
+func (l *linter) report(node syntax.Node, message string, fix *LintFix) {
+	l.reportSeverity(node, l.rule.Severity, message, fix)
+}
+
+// `reportSeverity` is `report` given the severity of this case of the rule, which options override.
+func (l *linter) reportSeverity(node syntax.Node, severity string, message string, fix *LintFix) {
+	line := node.Pos().Line()
+	if slices.Contains(l.disabled[0], l.rule.ID) || slices.Contains(l.disabled[line], l.rule.ID) {
+		return
+	}
+	if override, ok := l.opts.Severities[l.rule.ID]; ok {
+		severity = override
+	}
+	l.diags = append(l.diags, Diagnostic{
+		Rule:     l.rule.ID,
+		Severity: severity,
+		Message:  message,
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+		Fix:      fix,
+	})
+}
+
+// `parent` returns the `n`th ancestor of the current node, starting from 0.
+func (l *linter) parent(n int) syntax.Node {
+	if n >= len(l.stack) {
+		return nil
+	}
+	return l.stack[len(l.stack)-1-n]
+}
+
+func (l *linter) inFunction() bool {
+	for _, node := range l.stack {
+		if _, ok := node.(*syntax.FuncDecl); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func textEdit(pos, end syntax.Pos, newText string) TextEdit {
+	return TextEdit{Pos: mapPos(pos), End: mapPos(end), NewText: newText}
+}
+
+// `replaceBytes` edits the `n` bytes starting at `pos`, which must be on one line.
+func replaceBytes(pos syntax.Pos, n uint, newText string) TextEdit {
+	end := mapPos(pos)
+	end.Offset += n
+	end.Col += n
+	return TextEdit{Pos: mapPos(pos), End: end, NewText: newText}
+}
+
+func checkQuoteExpansion(l *linter, node syntax.Node) {
+	var words []*syntax.Word
+	switch node := node.(type) {
+	case *syntax.CallExpr:
+		if len(node.Args) > 1 {
+			words = node.Args[1:]
+		}
+	case *syntax.Redirect:
+		if node.Word != nil && node.Hdoc == nil {
+			words = []*syntax.Word{node.Word}
+		}
+	}
+	for _, word := range words {
+		for _, part := range word.Parts {
+			message := ""
+			switch part := part.(type) {
+			case *syntax.ParamExp:
+				if part.Length || part.Width || isNumericParam(part.Param.Value) {
+					continue
+				}
+				message = "Double quote to prevent word splitting e.g. \"$" + part.Param.Value + "\""
+			case *syntax.CmdSubst:
+				message = "Double quote to prevent word splitting e.g. \"$( … )\""
+			default:
+				continue
+			}
+			l.report(part, message, &LintFix{
+				Message: "Add double quotes",
+				Edits: []TextEdit{
+					textEdit(part.Pos(), part.Pos(), `"`),
+					textEdit(part.End(), part.End(), `"`),
+				},
+			})
+		}
+	}
+}
+
+// `isNumericParam` holds for special parameters which never contain whitespace.
+func isNumericParam(name string) bool {
+	switch name {
+	case "#", "?", "$", "!", "-":
+		return true
+	}
+	return false
+}
+
+func checkCdUnchecked(l *linter, node syntax.Node) {
+	call, ok := node.(*syntax.CallExpr)
+	if !ok || len(call.Args) == 0 || call.Args[0].Lit() != "cd" {
+		return
+	}
+	stmt, ok := l.parent(0).(*syntax.Stmt)
+	if !ok || stmt.Negated || len(l.stack) < 2 {
+		return
+	}
+	pipeline := false
+	switch parent := l.parent(1).(type) {
+	case *syntax.BinaryCmd:
+		if parent.Op == syntax.AndStmt || parent.Op == syntax.OrStmt {
+			return
+		}
+		pipeline = true
+	case *syntax.IfClause:
+		if slices.Contains(parent.Cond, stmt) {
+			return
+		}
+	case *syntax.WhileClause:
+		if slices.Contains(parent.Cond, stmt) {
+			return
+		}
+	}
+
+	// our runtime has `return` but not `exit`
+	var fix *LintFix
+	if l.inFunction() && !pipeline && !stmt.Background && !stmt.Coprocess {
+		// after any redirects e.g. `cd x 2>/dev/null`, but before `;` or `&`
+		end := call.End()
+		for _, r := range stmt.Redirs {
+			if r.End().After(end) {
+				end = r.End()
+			}
+		}
+		fix = &LintFix{
+			Message: "Append `|| return`",
+			Edits:   []TextEdit{textEdit(end, end, " || return")},
+		}
+	}
+	l.report(call, "Use `cd … || return` in case `cd` fails", fix)
+}
+
+func checkUnusedVariable(l *linter, node syntax.Node) {
+	file, ok := node.(*syntax.File)
+	if !ok {
+		return
+	}
+
+	assigned := []*syntax.Lit{}
+	used := map[string]bool{}
+	stack := []syntax.Node{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		switch node := node.(type) {
+		case *syntax.CallExpr:
+			if len(node.Args) == 0 {
+				for _, assign := range node.Assigns {
+					if assign.Name != nil && !assign.Append {
+						assigned = append(assigned, assign.Name)
+					}
+				}
+			}
+		case *syntax.DeclClause:
+			if node.Variant.Value != "export" {
+				for _, assign := range node.Args {
+					if assign.Name != nil && !assign.Append {
+						assigned = append(assigned, assign.Name)
+					}
+				}
+			}
+		case *syntax.ParamExp:
+			used[node.Param.Value] = true
+		case *syntax.Lit:
+			if len(stack) >= 2 && isArithmWord(stack[len(stack)-2], stack[len(stack)-1]) {
+				used[node.Value] = true
+			}
+		case *syntax.UnaryTest:
+			if word, ok := node.X.(*syntax.Word); ok && node.Op == syntax.TsVarSet {
+				used[word.Lit()] = true
+			}
+		}
+		stack = append(stack, node)
+		return true
+	})
+
+	for _, name := range assigned {
+		if used[name.Value] || strings.HasPrefix(name.Value, "_") || strings.ToUpper(name.Value) == name.Value {
+			continue
+		}
+		l.report(name, "`"+name.Value+"` is assigned but never used", nil)
+	}
+}
+
+// `isArithmWord` holds if `word` is an arithmetic operand of `parent`, so a name in it is a reference.
+func isArithmWord(parent, word syntax.Node) bool {
+	switch parent := parent.(type) {
+	case *syntax.BinaryArithm, *syntax.UnaryArithm, *syntax.ParenArithm,
+		*syntax.ArithmExp, *syntax.ArithmCmd, *syntax.LetClause, *syntax.CStyleLoop:
+		return true
+	case *syntax.ParamExp:
+		return parent.Index == word || parent.Slice != nil && (parent.Slice.Offset == word || parent.Slice.Length == word)
+	case *syntax.Assign:
+		return parent.Index == word
+	}
+	return false
+}
+
+func checkExitStatus(l *linter, node syntax.Node) {
+	switch node := node.(type) {
+	case *syntax.TestClause, *syntax.ArithmCmd:
+	case *syntax.CallExpr:
+		if len(node.Args) == 0 || node.Args[0].Lit() != "[" {
+			return
+		}
+	default:
+		return
+	}
+	syntax.Walk(node, func(child syntax.Node) bool {
+		if pe, ok := child.(*syntax.ParamExp); ok && pe.Param.Value == "?" {
+			l.report(pe, "Check the command directly e.g. `if cmd; then`, rather than via `$?`", nil)
+		}
+		return true
+	})
+}
+
+// `shellBuiltins` are bash's builtins which are valid names, along with common commands taking `=`.
+var shellBuiltins = []string{
+	"alias", "bg", "bind", "break", "builtin", "caller", "cd", "command", "compgen", "complete",
+	"compopt", "continue", "declare", "dirs", "disown", "echo", "enable", "eval", "exec", "exit",
+	"export", "false", "fc", "fg", "getopts", "hash", "help", "history", "jobs", "kill", "let",
+	"local", "logout", "mapfile", "popd", "printf", "pushd", "pwd", "read", "readarray", "readonly",
+	"return", "set", "shift", "shopt", "source", "suspend", "test", "times", "trap", "true", "type",
+	"typeset", "ulimit", "umask", "unalias", "unset", "wait",
+	"env", "expr",
+}
+
+func checkAssignSpaces(l *linter, node syntax.Node) {
+	call, ok := node.(*syntax.CallExpr)
+	if !ok || len(call.Args) < 2 || !syntax.ValidName(call.Args[0].Lit()) {
+		return
+	}
+	name, eq := call.Args[0], call.Args[1]
+	if len(eq.Parts) == 0 {
+		return
+	}
+	lit, ok := eq.Parts[0].(*syntax.Lit)
+	if !ok || !strings.HasPrefix(lit.Value, "=") {
+		return
+	}
+	// e.g. `test x = y` or `echo = foo`, where `=` is likely an argument
+	if slices.Contains(shellBuiltins, name.Lit()) || l.functions[name.Lit()] {
+		l.reportSeverity(call, SeverityWarning, "`"+name.Lit()+"` is a command, so `=` is its argument rather than an assignment", nil)
+		return
+	}
+
+	edits := []TextEdit{textEdit(name.End(), eq.Pos(), "")}
+	switch {
+	case eq.Lit() == "=" && len(call.Args) == 3:
+		// `x = y`
+		edits = append(edits, textEdit(eq.End(), call.Args[2].Pos(), ""))
+	case len(call.Args) == 2:
+		// `x =y` or `x =`
+	default:
+		edits = nil
+	}
+	var fix *LintFix
+	if edits != nil {
+		fix = &LintFix{Message: "Remove spaces around `=`", Edits: edits}
+	}
+	l.report(call, "Remove spaces around `=` to assign `"+name.Lit()+"`", fix)
+}
+
+func checkLocalOutsideFunction(l *linter, node syntax.Node) {
+	decl, ok := node.(*syntax.DeclClause)
+	if ok && decl.Variant.Value == "local" && !l.inFunction() {
+		l.report(decl.Variant, "`local` is only valid inside a function", nil)
+	}
+}
+
+func checkBackticks(l *linter, node syntax.Node) {
+	cs, ok := node.(*syntax.CmdSubst)
+	if !ok || !cs.Backquotes {
+		return
+	}
+	var fix *LintFix
+	// escapes inside backticks have different meaning, so only fix when there are none
+	if !slices.ContainsFunc(cs.Stmts, hasBackslash) {
+		fix = &LintFix{
+			Message: "Replace with `$( … )`",
+			Edits: []TextEdit{
+				replaceBytes(cs.Left, 1, "$("),
+				replaceBytes(cs.Right, 1, ")"),
+			},
+		}
+	}
+	l.report(cs, "Use `$( … )` instead of backticks", fix)
+}
+
+func hasBackslash(stmt *syntax.Stmt) bool {
+	found := false
+	syntax.Walk(stmt, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.Lit:
+			found = found || strings.Contains(node.Value, `\`)
+		case *syntax.SglQuoted:
+			found = found || strings.Contains(node.Value, `\`)
+		}
+		return !found
+	})
+	return found
+}
+
+func checkDeprecatedArithm(l *linter, node syntax.Node) {
+	ae, ok := node.(*syntax.ArithmExp)
+	if !ok || !ae.Bracket {
+		return
+	}
+	l.report(ae, "Use `$(( … ))` instead of `$[ … ]`", &LintFix{
+		Message: "Replace with `$(( … ))`",
+		Edits: []TextEdit{
+			replaceBytes(ae.Left, 2, "$(("),
+			replaceBytes(ae.Right, 1, "))"),
+		},
+	})
+}
+
+// `moduleRef` is a static reference e.g. `run core spawn` or `import fn1 fn2:alias from util`,
+// where `fns` is empty if only the module is referenced.
+type moduleRef struct {
+	module *syntax.Word
+	fns    []*syntax.Word
+}
+
+// `moduleRefs` finds module references of a command, as interpreted by our runtime.
+// A quoted or non-identifier operand is JavaScript e.g. `run '({ api }) { … }'` or `map x.foo`.
+func moduleRefs(modules map[string][]string, node syntax.Node) []moduleRef {
+	call, ok := node.(*syntax.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return nil
+	}
+	operands := []*syntax.Word{}
+	for _, arg := range call.Args[1:] {
+		if !strings.HasPrefix(arg.Lit(), "-") {
+			operands = append(operands, arg)
+		}
+	}
+	if len(operands) == 0 {
+		return nil
+	}
+	first := operands[0].Lit()
+	if call.Args[0].Lit() == "import" {
+		// `import fn:alias from module`
+		first, _, _ = strings.Cut(first, ":")
+	}
+	if !syntax.ValidName(first) {
+		return nil
+	}
+	_, isModule := modules[first]
+
+	switch call.Args[0].Lit() {
+	case "run":
+		if len(operands) == 1 {
+			return []moduleRef{{module: operands[0]}}
+		}
+		return []moduleRef{{module: operands[0], fns: operands[1:2]}}
+	case "map":
+		// `map foo 3` may apply selector `foo`, but `map foo bar` must be module `foo`
+		if len(operands) >= 2 && (isModule || len(operands) == 2 && syntax.ValidName(operands[1].Lit())) {
+			return []moduleRef{{module: operands[0], fns: operands[1:2]}}
+		}
+	case "import":
+		if len(operands) == 1 {
+			return []moduleRef{{module: operands[0]}}
+		}
+		last := len(operands) - 1
+		if last >= 2 && operands[last-1].Lit() == "from" {
+			return []moduleRef{{module: operands[last], fns: operands[:last-1]}}
+		}
+	}
+	return nil
+}
+
+func checkUnknownModule(l *linter, node syntax.Node) {
+	for _, ref := range moduleRefs(l.opts.Modules, node) {
+		name := ref.module.Lit()
+		if _, ok := l.opts.Modules[name]; !ok {
+			l.report(ref.module, "Unknown module `"+name+"`", nil)
+		}
+	}
+}
+
+func checkUnknownModuleFunction(l *linter, node syntax.Node) {
+	for _, ref := range moduleRefs(l.opts.Modules, node) {
+		name := ref.module.Lit()
+		fns, ok := l.opts.Modules[name]
+		if !ok {
+			continue
+		}
+		for _, word := range ref.fns {
+			// `import fn:alias from module`
+			fn, _, _ := strings.Cut(word.Lit(), ":")
+			if fn != "" && !slices.Contains(fns, fn) {
+				l.report(word, "Module `"+name+"` has no function `"+fn+"`", nil)
+			}
+		}
+	}
+}
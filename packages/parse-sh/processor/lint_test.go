@@ -0,0 +1,123 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+// `applyEdits` applies non-overlapping edits, as an editor would.
+func applyEdits(text string, edits []TextEdit) string {
+	edits = slices.Clone(edits)
+	slices.SortFunc(edits, func(a, b TextEdit) int { return int(b.Pos.Offset) - int(a.Pos.Offset) })
+	for _, edit := range edits {
+		text = text[:edit.Pos.Offset] + edit.NewText + text[edit.End.Offset:]
+	}
+	return text
+}
+
+func TestLintFixes(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		text string
+		// the fixed text, or "" if the diagnostic has no fix
+		fixed string
+	}{
+		{"quote", "quote-expansion", "echo $x", `echo "$x"`},
+		{"quote cmd subst", "quote-expansion", "echo $(ls)", `echo "$(ls)"`},
+		{"cd", "cd-unchecked", "f() { cd /tmp; }", "f() { cd /tmp || return; }"},
+		{"cd redirect", "cd-unchecked", "f() { cd /tmp 2>/dev/null; ls; }", "f() { cd /tmp 2>/dev/null || return; ls; }"},
+		{"cd redirect newline", "cd-unchecked", "f() {\n  cd /tmp >/dev/null\n}", "f() {\n  cd /tmp >/dev/null || return\n}"},
+		{"cd pipeline", "cd-unchecked", "f() { cd /tmp | cat; }", ""},
+		{"cd background", "cd-unchecked", "f() { cd /tmp & }", ""},
+		{"cd top level", "cd-unchecked", "cd /tmp", ""},
+		{"assign spaces", "assign-spaces", "x = 1", "x=1"},
+		{"assign space before", "assign-spaces", "x =1", "x=1"},
+		{"assign spaces to a builtin", "assign-spaces", "echo = foo", ""},
+		{"assign spaces to a function", "assign-spaces", "f() { :; }; f = 1", ""},
+		{"backticks", "backticks", "echo `ls`", "echo $(ls)"},
+		{"deprecated arithm", "deprecated-arithm", "echo $[1 + 2]", "echo $((1 + 2))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found *Diagnostic
+			for _, diag := range Lint(file, LintOptions{}) {
+				if diag.Rule == tt.rule {
+					found = &diag
+					break
+				}
+			}
+			if found == nil {
+				t.Fatalf("no %s diagnostic", tt.rule)
+			}
+			if found.Fix == nil {
+				if tt.fixed != "" {
+					t.Fatalf("no fix, want %q", tt.fixed)
+				}
+				return
+			}
+			if fixed := applyEdits(tt.text, found.Fix.Edits); fixed != tt.fixed {
+				t.Errorf("fixed = %q, want %q", fixed, tt.fixed)
+			}
+		})
+	}
+}
+
+func TestLintNoDiagnostic(t *testing.T) {
+	tests := []struct {
+		rule string
+		text string
+	}{
+		{"cd-unchecked", "f() { cd /tmp || return; }"},
+		{"cd-unchecked", "f() { cd /tmp && ls; }"},
+		{"cd-unchecked", "if cd /tmp; then ls; fi"},
+		{"quote-expansion", `echo "$x" $#`},
+		{"local-outside-function", "f() { local x; }"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, diag := range Lint(file, LintOptions{}) {
+				if diag.Rule == tt.rule {
+					t.Errorf("unexpected %s diagnostic: %s", tt.rule, diag.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestLintAssignSpacesSeverity(t *testing.T) {
+	tests := []struct {
+		text     string
+		// "" if not reported
+		severity string
+	}{
+		{"x = 1", SeverityError},
+		{"echo = foo", SeverityWarning},
+		{"f() { :; }; f = 1", SeverityWarning},
+		{"test x = y", ""},
+	}
+	for _, tt := range tests {
+		file, err := Parse(tt.text, "", ParserOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		diags := Lint(file, LintOptions{})
+		if tt.severity == "" {
+			if len(diags) > 0 {
+				t.Errorf("%q: diagnostics = %+v, want none", tt.text, diags)
+			}
+			continue
+		}
+		if len(diags) != 1 || diags[0].Rule != "assign-spaces" || diags[0].Severity != tt.severity {
+			t.Errorf("%q: diagnostics = %+v, want one %s", tt.text, diags, tt.severity)
+		}
+	}
+}
@@ -22,6 +22,11 @@ type ParserOptions struct {
 	Variant       syntax.LangVariant
 	StopAt        string
 	RecoverErrors int
+	// expanded at command name positions, see `ExpandAliases`
+	Aliases       map[string]string
+	Limits        ParseLimits
+	// also list the nodes by ID, see `NodeTable`
+	NodeTable     bool
 }
 
 type SyntaxOptions struct {
@@ -36,7 +41,8 @@ type SyntaxOptions struct {
 func Parse(text string, filepath string, parserOptions ParserOptions) (*syntax.File, error) {
 	var options []syntax.ParserOption
 
-	options = append(options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(parserOptions.Variant))
+	variant, _ := ResolveVariant(text, filepath, parserOptions.Variant)
+	options = append(options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(variant))
 
 	if parserOptions.StopAt != "" {
 		options = append(options, syntax.StopAt(parserOptions.StopAt))
@@ -46,22 +52,74 @@ func Parse(text string, filepath string, parserOptions ParserOptions) (*syntax.F
 		options = append(options, syntax.RecoverErrors(parserOptions.RecoverErrors))
 	}
 
+	if err := checkInput(text, parserOptions.Limits); err != nil {
+		return &syntax.File{Name: filepath}, err
+	}
+
 	parser = syntax.NewParser(options...)
 
-	return parser.Parse(bytes.NewReader([]byte(text)), filepath)
+	file, err := parser.Parse(bytes.NewReader([]byte(text)), filepath)
+	fillEmptyHeredocs(file, text)
+	if err == nil {
+		if err := checkFile(file, parserOptions.Limits); err != nil {
+			return &syntax.File{Name: filepath}, err
+		}
+	}
+	return file, err
 }
 
 func InteractiveParse(text string, filepath string, parserOptions ParserOptions) (*syntax.File, error) {
 	var options []syntax.ParserOption
 
-	options = append(options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(parserOptions.Variant))
+	variant, _ := ResolveVariant(text, filepath, parserOptions.Variant)
+	options = append(options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(variant))
+
+	if err := checkInput(text, parserOptions.Limits); err != nil {
+		return &syntax.File{Name: filepath}, err
+	}
 
 	parser = syntax.NewParser(options...)
 	parser.Interactive(bytes.NewReader([]byte(text)), TrackIncomplete);
 
 	if (incomplete) {
 		return nil, nil;
-	} else {
-		return parser.Parse(bytes.NewReader([]byte(text)), filepath);
 	}
+
+	file, err := parser.Parse(bytes.NewReader([]byte(text)), filepath);
+	fillEmptyHeredocs(file, text)
+	if err == nil {
+		if err := checkFile(file, parserOptions.Limits); err != nil {
+			return &syntax.File{Name: filepath}, err
+		}
+	}
+	return file, err
+}
+
+// `ParserOptions` resolves the options of `item` against the batch's defaults.
+func (b Batch) ParserOptions(item BatchItem) ParserOptions {
+	options := ParserOptions{Aliases: map[string]string{}}
+	for _, o := range []BatchOptions{b.Defaults, item.Options} {
+		if o.KeepComments != nil {
+			options.KeepComments = *o.KeepComments
+		}
+		if o.Variant != nil {
+			options.Variant = syntax.LangVariant(*o.Variant)
+		}
+		if o.StopAt != nil {
+			options.StopAt = *o.StopAt
+		}
+		if o.RecoverErrors != nil {
+			options.RecoverErrors = *o.RecoverErrors
+		}
+		for name, value := range o.Aliases {
+			options.Aliases[name] = value
+		}
+		if o.Limits != nil {
+			options.Limits = *o.Limits
+		}
+		if o.NodeTable != nil {
+			options.NodeTable = *o.NodeTable
+		}
+	}
+	return options
 }
@@ -0,0 +1,359 @@
+package processor
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+type policyChecker struct {
+	policy Policy
+	// functions defined by the script, whose calls are always allowed
+	functions map[string]*syntax.FuncDecl
+	diags     []Diagnostic
+}
+
+// `CheckPolicy` reports every construct of `file` which `policy` forbids, so a script
+// can be refused or sandboxed before it runs.
+//
+// Checks are static: e.g. `$cmd` violates an allow-list because its command is unknown,
+// and relative redirect targets are matched as written.
+func CheckPolicy(file *syntax.File, policy Policy) []Diagnostic {
+	c := &policyChecker{policy: policy, functions: map[string]*syntax.FuncDecl{}, diags: []Diagnostic{}}
+	c.check(file)
+
+	slices.SortStableFunc(c.diags, func(a, b Diagnostic) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	return c.diags
+}
+
+func (c *policyChecker) check(file *syntax.File) {
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if fn, ok := node.(*syntax.FuncDecl); ok {
+			c.functions[fn.Name.Value] = fn
+		}
+		return true
+	})
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.Stmt:
+			if node.Background && c.policy.DenyBackground {
+				c.report("background", node, "Background jobs are not allowed")
+			}
+			for _, r := range node.Redirs {
+				c.redirect(r)
+			}
+		case *syntax.CoprocClause:
+			if c.policy.DenyCoproc {
+				c.report("coproc", node, "Coprocesses are not allowed")
+			}
+		case *syntax.CmdSubst:
+			if c.policy.DenyCmdSubst {
+				c.report("cmd-subst", node, "Command substitution is not allowed")
+			}
+		case *syntax.ProcSubst:
+			if c.policy.DenyCmdSubst {
+				c.report("cmd-subst", node, "Process substitution is not allowed")
+			}
+		case *syntax.CallExpr:
+			if len(node.Args) > 0 {
+				c.call(node)
+			}
+		}
+		return true
+	})
+
+	if c.policy.MaxCallDepth > 0 {
+		c.callDepth(file)
+	}
+}
+
+func (c *policyChecker) report(rule string, node syntax.Node, message string) {
+	c.diags = append(c.diags, Diagnostic{
+		Rule:     rule,
+		Severity: SeverityError,
+		Message:  message,
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+	})
+}
+
+func (c *policyChecker) call(call *syntax.CallExpr) {
+	args := call.Args
+	// e.g. `command rm`, `exec rm` or `env X=1 rm` runs `rm`, even if a function `rm` exists
+	for unwrapped := false; ; unwrapped = true {
+		name, static := staticString(args[0])
+		if !static {
+			if len(c.policy.AllowCommands) > 0 {
+				c.report("dynamic-command", args[0], "Command is not known statically")
+			}
+			return
+		}
+		if _, ok := c.functions[name]; !ok || unwrapped {
+			switch {
+			case slices.Contains(c.policy.DenyCommands, name):
+				c.report("denied-command", args[0], "Command `"+name+"` is not allowed")
+			case len(c.policy.AllowCommands) > 0 && !slices.Contains(c.policy.AllowCommands, name):
+				c.report("denied-command", args[0], "Command `"+name+"` is not allowed")
+			}
+		}
+
+		switch name {
+		case "eval":
+			c.eval(name, args[1:], c.functions)
+		case "source", ".":
+			if !c.policy.DenyDynamicEval {
+				break
+			}
+			for _, arg := range args[1:] {
+				if _, ok := staticString(arg); !ok {
+					c.report("dynamic-eval", arg, "`"+name+"` of a dynamic word is not allowed")
+				}
+			}
+		case "sh", "bash", "dash", "ksh", "mksh", "zsh":
+			if script := shellScriptArg(args[1:]); script != nil {
+				// a new shell lacks the script's functions
+				c.eval(name+" -c", []*syntax.Word{script}, map[string]*syntax.FuncDecl{})
+			}
+		default:
+			if wrapped := wrappedCommand(name, args); wrapped != nil {
+				args = wrapped
+				continue
+			}
+		}
+		return
+	}
+}
+
+// `commandWrapper` describes the operands of a command running another e.g. `nohup rm x`.
+type commandWrapper struct {
+	// options taking the next word e.g. `-u` of `env -u NAME`
+	argOptions []string
+	// whether `NAME=value` operands precede the command e.g. `env X=1 rm`
+	assigns bool
+	// further operands preceding the command e.g. the duration of `timeout 5 rm`
+	operands int
+}
+
+var commandWrappers = map[string]commandWrapper{
+	"command": {},
+	"builtin": {},
+	"exec":    {argOptions: []string{"-a"}},
+	"env":     {argOptions: []string{"-u", "--unset", "-C", "--chdir"}, assigns: true},
+	"nohup":   {},
+	"nice":    {argOptions: []string{"-n", "--adjustment"}},
+	"timeout": {argOptions: []string{"-s", "--signal", "-k", "--kill-after"}, operands: 1},
+	"xargs": {argOptions: []string{
+		"-a", "--arg-file", "-d", "--delimiter", "-E", "-I", "-L", "--max-lines",
+		"-n", "--max-args", "-P", "--max-procs", "-s", "--max-chars",
+	}},
+	"sudo": {argOptions: []string{
+		"-C", "--close-from", "-D", "--chdir", "-g", "--group", "-h", "--host", "-p", "--prompt",
+		"-R", "--chroot", "-r", "--role", "-T", "--command-timeout", "-t", "--type", "-U", "--other-user", "-u", "--user",
+	}, assigns: true},
+}
+
+// `wrappedCommand` returns the words of the command run by a wrapper e.g. `rm x` of
+// `env -i X=1 rm x`, else nil e.g. if it has none or its options are unknown.
+func wrappedCommand(name string, args []*syntax.Word) []*syntax.Word {
+	wrapper, ok := commandWrappers[name]
+	if !ok {
+		return nil
+	}
+	operands := wrapper.operands
+	for i := 1; i < len(args); i++ {
+		arg := args[i].Lit()
+		switch {
+		case arg == "--":
+			if i+1+operands < len(args) {
+				return args[i+1+operands:]
+			}
+			return nil
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			// e.g. `env -S 'rm x'` splits a word into a command
+			if name == "env" && (strings.HasPrefix(arg, "-S") || strings.HasPrefix(arg, "--split-string")) {
+				return nil
+			}
+			if slices.Contains(wrapper.argOptions, arg) {
+				i++
+			}
+		case wrapper.assigns && strings.Contains(arg, "=") && syntax.ValidName(arg[:strings.Index(arg, "=")]):
+		case operands > 0:
+			operands--
+		default:
+			return args[i:]
+		}
+	}
+	return nil
+}
+
+// `eval` checks the code run by `eval` or `sh -c` if it is static, reporting violations at `args`.
+// Dynamic or unparsable code is reported given `DenyDynamicEval`.
+func (c *policyChecker) eval(name string, args []*syntax.Word, functions map[string]*syntax.FuncDecl) {
+	if len(args) == 0 {
+		return
+	}
+	words := make([]string, len(args))
+	for i, arg := range args {
+		word, ok := staticString(arg)
+		if !ok {
+			if c.policy.DenyDynamicEval {
+				c.report("dynamic-eval", arg, "`"+name+"` of a dynamic word is not allowed")
+			}
+			return
+		}
+		words[i] = word
+	}
+	// `eval` joins its arguments with spaces
+	file, err := syntax.NewParser().Parse(strings.NewReader(strings.Join(words, " ")), "")
+	if err != nil {
+		if c.policy.DenyDynamicEval {
+			c.report("dynamic-eval", args[0], "`"+name+"` of code which does not parse is not allowed")
+		}
+		return
+	}
+
+	inner := &policyChecker{policy: c.policy, functions: maps.Clone(functions), diags: []Diagnostic{}}
+	inner.check(file)
+	for _, diag := range inner.diags {
+		diag.Message += " (in `" + name + "` code)"
+		diag.Pos, diag.End = mapPos(args[0].Pos()), mapPos(args[len(args)-1].End())
+		c.diags = append(c.diags, diag)
+	}
+}
+
+// `shellScriptArg` is the script of e.g. `sh -c 'rm x'` or `bash -ec 'rm x'`, else nil.
+func shellScriptArg(args []*syntax.Word) *syntax.Word {
+	command := false
+	for _, arg := range args {
+		flag := arg.Lit()
+		if flag == "--" || !strings.HasPrefix(flag, "-") && !strings.HasPrefix(flag, "+") {
+			if command {
+				return arg
+			}
+			return nil
+		}
+		if !strings.HasPrefix(flag, "--") && strings.ContainsRune(flag, 'c') {
+			command = true
+		}
+	}
+	return nil
+}
+
+func (c *policyChecker) redirect(r *syntax.Redirect) {
+	if len(c.policy.DenyRedirectPaths) == 0 || r.Word == nil {
+		return
+	}
+	switch r.Op {
+	case syntax.Hdoc, syntax.DashHdoc, syntax.WordHdoc:
+		return
+	}
+	target, ok := staticString(r.Word)
+	// e.g. `2>&1` or `<&-`, whereas `>&file` is `&>file`
+	if ok && (r.Op == syntax.DplIn || r.Op == syntax.DplOut) && (target == "-" || isDigits(strings.TrimSuffix(target, "-"))) {
+		return
+	}
+	if !ok {
+		c.report("dynamic-redirect", r.Word, "Redirect target is not known statically")
+		return
+	}
+	for _, pattern := range c.policy.DenyRedirectPaths {
+		if matchPathPattern(pattern, target) {
+			c.report("redirect-path", r.Word, "Redirect to `"+target+"` is not allowed")
+			return
+		}
+	}
+}
+
+// `matchPathPattern` matches `path.Match` patterns, where a directory also matches its descendants
+// e.g. `/etc` matches `/etc/passwd`.
+func matchPathPattern(pattern, target string) bool {
+	if path.IsAbs(target) {
+		target = path.Clean(target)
+	}
+	for p := target; ; p = path.Dir(p) {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+		if p == path.Dir(p) || !strings.Contains(p, "/") {
+			return false
+		}
+	}
+}
+
+// `callDepth` reports recursion between functions of the script, and calls
+// whose chain of nested function calls exceeds `MaxCallDepth`.
+func (c *policyChecker) callDepth(file *syntax.File) {
+	// function name to its calls of functions, where "" is the top level
+	calls := map[string][]*syntax.CallExpr{}
+	stack := []syntax.Node{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		defer func() { stack = append(stack, node) }()
+
+		if call, ok := node.(*syntax.CallExpr); ok && c.calledFunction(call) != "" {
+			caller := ""
+			for i := len(stack) - 1; i >= 0; i-- {
+				if fn, ok := stack[i].(*syntax.FuncDecl); ok {
+					caller = fn.Name.Value
+					break
+				}
+			}
+			calls[caller] = append(calls[caller], call)
+		}
+		return true
+	})
+
+	depths := map[string]int{}
+	state := map[string]int{} // 1 visiting, 2 done
+	var depth func(name string) int
+	depth = func(name string) int {
+		if state[name] == 2 {
+			return depths[name]
+		}
+		state[name] = 1
+		deepest := 0
+		for _, call := range calls[name] {
+			callee := c.calledFunction(call)
+			if state[callee] == 1 {
+				c.report("recursion", call.Args[0], "Recursive call of `"+callee+"` is not allowed")
+				continue
+			}
+			deepest = max(deepest, depth(callee))
+		}
+		state[name] = 2
+		depths[name] = deepest + 1
+		return depths[name]
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.functions)) {
+		depth(name)
+	}
+
+	for _, call := range calls[""] {
+		name := c.calledFunction(call)
+		if d := depths[name]; d > c.policy.MaxCallDepth {
+			c.report("call-depth", call.Args[0], "Calling `"+name+"` nests "+strconv.Itoa(d)+" function calls, exceeding "+strconv.Itoa(c.policy.MaxCallDepth))
+		}
+	}
+}
+
+// `calledFunction` is the name of the script's function invoked by `call`, else "".
+func (c *policyChecker) calledFunction(call *syntax.CallExpr) string {
+	if len(call.Args) == 0 {
+		return ""
+	}
+	name, ok := staticString(call.Args[0])
+	if _, defined := c.functions[name]; !ok || !defined {
+		return ""
+	}
+	return name
+}
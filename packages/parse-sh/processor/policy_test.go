@@ -0,0 +1,70 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		policy Policy
+		rules  []string
+	}{
+		{"denied command", "rm -rf /", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"allow-list", "ls; cat x", Policy{AllowCommands: []string{"ls"}}, []string{"denied-command"}},
+		{"function exempt", "kill() { :; }; kill 1", Policy{DenyCommands: []string{"kill"}}, nil},
+		{"command skips functions", "kill() { :; }; command kill 1", Policy{DenyCommands: []string{"kill"}}, []string{"denied-command"}},
+		{"builtin skips functions", "kill() { :; }; builtin kill 1", Policy{DenyCommands: []string{"kill"}}, []string{"denied-command"}},
+		{"exec", "exec -a x rm y", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"env", "env -i -u HOME X=1 rm -rf /", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"env only", "env X=1", Policy{DenyCommands: []string{"rm"}}, nil},
+		{"nohup", "nohup rm x", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"nice", "nice -n 5 rm x", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"timeout", "timeout -s KILL 5 rm x", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"timeout duration", "timeout 5", Policy{DenyCommands: []string{"5"}}, nil},
+		{"xargs", "xargs -I {} rm {}", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"sudo", "sudo -u root rm x", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"nested wrappers", "sudo env nohup rm x", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"wrapped dynamic command", "env $cmd", Policy{AllowCommands: []string{"env"}}, []string{"dynamic-command"}},
+		{"wrapped allow-list", "nohup rm x", Policy{AllowCommands: []string{"nohup"}}, []string{"denied-command"}},
+		{"dynamic command", "$cmd x", Policy{AllowCommands: []string{"ls"}}, []string{"dynamic-command"}},
+		{"static eval", "eval 'kill 1'", Policy{DenyCommands: []string{"kill"}}, []string{"denied-command"}},
+		{"static eval joins args", "eval kill 1", Policy{DenyCommands: []string{"kill"}}, []string{"denied-command"}},
+		{"static eval allow-list", "eval 'rm x'", Policy{AllowCommands: []string{"eval"}}, []string{"denied-command"}},
+		{"eval function", "f() { :; }; eval f", Policy{AllowCommands: []string{"eval", ":"}}, nil},
+		{"sh -c", "sh -c 'rm -rf /'", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"bash -ec", "bash -ec 'rm -rf /' name", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"sh -c lacks functions", "rm() { :; }; sh -c 'rm x'", Policy{DenyCommands: []string{"rm"}}, []string{"denied-command"}},
+		{"sh script", "sh script.sh", Policy{DenyCommands: []string{"rm"}}, nil},
+		{"dynamic eval", `eval "$x"`, Policy{DenyDynamicEval: true}, []string{"dynamic-eval"}},
+		{"dynamic sh -c", `sh -c "$x"`, Policy{DenyDynamicEval: true}, []string{"dynamic-eval"}},
+		{"unparsable eval", "eval 'if'", Policy{DenyDynamicEval: true}, []string{"dynamic-eval"}},
+		{"dynamic source", "source $f", Policy{DenyDynamicEval: true}, []string{"dynamic-eval"}},
+		{"redirect path", "echo x >/etc/passwd", Policy{DenyRedirectPaths: []string{"/etc"}}, []string{"redirect-path"}},
+		{"dup to file", "echo x >& /etc/passwd", Policy{DenyRedirectPaths: []string{"/etc"}}, []string{"redirect-path"}},
+		{"dup fd", "echo x 2>&1 >&2 <&- 3>&1-", Policy{DenyRedirectPaths: []string{"*"}}, nil},
+		{"dynamic dup", "echo x >&$fd", Policy{DenyRedirectPaths: []string{"/etc"}}, []string{"dynamic-redirect"}},
+		{"heredoc", "cat <<EOF\nx\nEOF", Policy{DenyRedirectPaths: []string{"*"}}, nil},
+		{"background", "sleep 1 &", Policy{DenyBackground: true}, []string{"background"}},
+		{"cmd subst", "echo $(ls) <(ls)", Policy{DenyCmdSubst: true}, []string{"cmd-subst", "cmd-subst"}},
+		{"recursion", "f() { f; }; f", Policy{MaxCallDepth: 10}, []string{"recursion"}},
+		{"call depth", "a() { :; }; b() { a; }; b", Policy{MaxCallDepth: 1}, []string{"call-depth"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			rules := []string{}
+			for _, diag := range CheckPolicy(file, tt.policy) {
+				rules = append(rules, diag.Rule)
+			}
+			if !slices.Equal(rules, append([]string{}, tt.rules...)) {
+				t.Errorf("rules = %v, want %v", rules, tt.rules)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+var (
+	allVariants = []syntax.LangVariant{syntax.LangBash, syntax.LangPOSIX, syntax.LangMirBSDKorn, syntax.LangBats}
+	// bash and mksh, sharing ksh's ancestry
+	kshVariants  = []syntax.LangVariant{syntax.LangBash, syntax.LangMirBSDKorn, syntax.LangBats}
+	bashVariants = []syntax.LangVariant{syntax.LangBash, syntax.LangBats}
+	mkshVariants = []syntax.LangVariant{syntax.LangMirBSDKorn}
+	batsVariants = []syntax.LangVariant{syntax.LangBats}
+)
+
+type portabilityChecker struct {
+	target syntax.LangVariant
+	issues []PortabilityIssue
+}
+
+// `CheckPortability` lists constructs of `file` which `target` lacks, e.g. bashisms when targeting
+// POSIX sh, along with the variants supporting each one.
+//
+// Constructs are found via node types, as parsed under the file's own variant. Since that cannot
+// catch everything, `text` is also reparsed under each variant: the result lists the variants
+// which parse it, and the error under `target` is reported unless a construct covers it.
+func CheckPortability(file *syntax.File, text string, target syntax.LangVariant) PortabilityResult {
+	c := &portabilityChecker{target: target, issues: []PortabilityIssue{}}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		c.check(node)
+		return true
+	})
+
+	result := PortabilityResult{Variants: []string{}}
+	var targetErr error
+	for _, variant := range allVariants {
+		_, err := syntax.NewParser(syntax.Variant(variant)).Parse(strings.NewReader(text), file.Name)
+		if err == nil {
+			result.Variants = append(result.Variants, variant.String())
+		} else if variant == target {
+			targetErr = err
+		}
+	}
+	if targetErr != nil {
+		c.reparseIssue(targetErr, result.Variants)
+	}
+
+	slices.SortStableFunc(c.issues, func(a, b PortabilityIssue) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	result.Issues = c.issues
+	return result
+}
+
+func (c *portabilityChecker) check(node syntax.Node) {
+	switch node := node.(type) {
+	case *syntax.TestClause:
+		c.report(node, "[[ ]]", kshVariants)
+	case *syntax.ArithmCmd:
+		c.report(node, "(( ))", kshVariants)
+	case *syntax.ArrayExpr:
+		c.report(node, "array", kshVariants)
+	case *syntax.Assign:
+		if node.Index != nil {
+			c.report(node, "array element assignment", kshVariants)
+		}
+	case *syntax.ParamExp:
+		switch {
+		case node.Index != nil:
+			c.report(node, "array index", kshVariants)
+		case node.Excl:
+			c.report(node, "${!name}", kshVariants)
+		case node.Slice != nil:
+			c.report(node, "${name:offset:length}", kshVariants)
+		case node.Repl != nil:
+			c.report(node, "${name/pattern/string}", kshVariants)
+		case node.Exp != nil && strings.ContainsAny(node.Exp.Op.String(), "^,"):
+			c.report(node, "${name^^}", bashVariants)
+		}
+	case *syntax.SglQuoted:
+		if node.Dollar {
+			c.report(node, "$'…'", kshVariants)
+		}
+	case *syntax.DblQuoted:
+		if node.Dollar {
+			c.report(node, `$"…"`, bashVariants)
+		}
+	case *syntax.FuncDecl:
+		if node.RsrvWord {
+			c.report(node, "function keyword", kshVariants)
+		}
+	case *syntax.DeclClause:
+		c.declaration(node, node.Variant.Value)
+	case *syntax.CallExpr:
+		// parsed as a command given posix
+		if len(node.Args) > 0 {
+			if name, ok := staticString(node.Args[0]); ok {
+				c.declaration(node, name)
+			}
+			c.braces(node.Args)
+		}
+	case *syntax.WordIter:
+		c.braces(node.Items)
+	case *syntax.ProcSubst:
+		c.report(node, "process substitution", bashVariants)
+	case *syntax.CmdSubst:
+		if node.TempFile || node.ReplyVar {
+			c.report(node, "${ …;}", mkshVariants)
+		}
+	case *syntax.ForClause:
+		if node.Select {
+			c.report(node, "select", kshVariants)
+		} else if _, ok := node.Loop.(*syntax.CStyleLoop); ok {
+			c.report(node, "for (( ))", kshVariants)
+		}
+	case *syntax.ExtGlob:
+		c.report(node, "extglob", kshVariants)
+	case *syntax.LetClause:
+		c.report(node, "let", kshVariants)
+	case *syntax.CoprocClause:
+		c.report(node, "coproc", bashVariants)
+	case *syntax.TestDecl:
+		c.report(node, "@test", batsVariants)
+	case *syntax.BinaryCmd:
+		if node.Op == syntax.PipeAll {
+			c.report(node, "|&", bashVariants)
+		}
+	case *syntax.Redirect:
+		switch node.Op {
+		case syntax.RdrAll, syntax.AppAll:
+			c.report(node, node.Op.String(), kshVariants)
+		case syntax.WordHdoc:
+			c.report(node, "<<<", kshVariants)
+		}
+	}
+}
+
+// `reparseIssue` reports the error of reparsing under the target, e.g. an unsupported
+// feature such as `${x@Q}`, unless an issue covers its position.
+func (c *portabilityChecker) reparseIssue(err error, variants []string) {
+	issue := PortabilityIssue{Construct: "syntax", Variants: variants}
+	var pos syntax.Pos
+	switch err := err.(type) {
+	case syntax.LangError:
+		pos = err.Pos
+		issue.Construct = err.Feature
+		issue.Message = "`" + err.Feature + "` is not supported by " + c.target.String()
+		issue.Variants = make([]string, len(err.Langs))
+		for i, variant := range err.Langs {
+			issue.Variants[i] = variant.String()
+		}
+	case syntax.ParseError:
+		pos = err.Pos
+		issue.Message = "Does not parse as " + c.target.String() + ": " + err.Text
+	default:
+		return
+	}
+	for _, other := range c.issues {
+		if other.Pos.Offset <= pos.Offset() && pos.Offset() < other.End.Offset {
+			return
+		}
+	}
+	issue.Pos, issue.End = mapPos(pos), mapPos(pos)
+	c.issues = append(c.issues, issue)
+}
+
+func (c *portabilityChecker) declaration(node syntax.Node, name string) {
+	switch name {
+	case "local", "typeset":
+		c.report(node, name, kshVariants)
+	case "declare", "nameref":
+		c.report(node, name, bashVariants)
+	}
+}
+
+// `braces` reports brace expansions e.g. `{a,b}` or `{1..3}`, which the parser leaves as literals.
+func (c *portabilityChecker) braces(words []*syntax.Word) {
+	for _, word := range words {
+		// `SplitBraces` replaces parts
+		split := &syntax.Word{Parts: slices.Clone(word.Parts)}
+		if !syntax.SplitBraces(split) {
+			continue
+		}
+		for _, part := range split.Parts {
+			if brace, ok := part.(*syntax.BraceExp); ok && brace.Sequence {
+				c.report(word, "{a..b}", bashVariants)
+				break
+			} else if ok {
+				c.report(word, "{a,b}", kshVariants)
+				break
+			}
+		}
+	}
+}
+
+func (c *portabilityChecker) report(node syntax.Node, construct string, variants []syntax.LangVariant) {
+	if slices.Contains(variants, c.target) {
+		return
+	}
+	names := make([]string, len(variants))
+	for i, variant := range variants {
+		names[i] = variant.String()
+	}
+	c.issues = append(c.issues, PortabilityIssue{
+		Construct: construct,
+		Type:      nodeTypeName(node),
+		Message:   "`" + construct + "` is not supported by " + c.target.String(),
+		Pos:       mapPos(node.Pos()),
+		End:       mapPos(node.End()),
+		Variants:  names,
+	})
+}
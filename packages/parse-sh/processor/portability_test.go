@@ -0,0 +1,56 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+func TestCheckPortability(t *testing.T) {
+	tests := []struct {
+		text   string
+		target syntax.LangVariant
+		// constructs reported, in source order
+		want []string
+	}{
+		{"[[ -n $x ]]", syntax.LangPOSIX, []string{"[[ ]]"}},
+		{"[[ -n $x ]]", syntax.LangBash, []string{}},
+		{"a=(1 2); echo ${a[0]} ${x^^}", syntax.LangPOSIX, []string{"array", "array index", "${name^^}"}},
+		{"echo ${x^^}", syntax.LangMirBSDKorn, []string{"${name^^}"}},
+		{"local x; declare y", syntax.LangMirBSDKorn, []string{"declare"}},
+		{"echo {a,b} {1..3}", syntax.LangPOSIX, []string{"{a,b}", "{a..b}"}},
+		{"cat <(ls) <<<x", syntax.LangPOSIX, []string{"process substitution", "<<<"}},
+		// only found by reparsing
+		{"echo ${x@Q}", syntax.LangPOSIX, []string{"this expansion operator"}},
+		{"echo hi", syntax.LangPOSIX, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target.String()+": "+tt.text, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{Variant: syntax.LangBash})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := CheckPortability(file, tt.text, tt.target)
+			got := []string{}
+			for _, issue := range result.Issues {
+				got = append(got, issue.Construct)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("constructs = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPortabilityVariants(t *testing.T) {
+	text := "echo ${x^^}"
+	file, err := Parse(text, "", ParserOptions{Variant: syntax.LangBash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := CheckPortability(file, text, syntax.LangPOSIX)
+	if want := []string{"bash", "bats"}; !slices.Equal(result.Variants, want) {
+		t.Errorf("variants = %q, want %q", result.Variants, want)
+	}
+}
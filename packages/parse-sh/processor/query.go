@@ -0,0 +1,445 @@
+package processor
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Query` finds the nodes of `file` matching `selector`, in source order, where:
+//   - `CallExpr` matches a node type, and `*` any node
+//   - `[Field=value]` compares a field, case-insensitively named, as text e.g. `Assign[name=PATH]`
+//     or `BinaryCmd[op=&&]`, also supporting `!=`, `^=`, `$=`, `*=` and `[Field]` for non-empty fields
+//   - `CallExpr` also has `[arg0=npc]`, `[arg1=…]` and `[argc=2]`, and every node has `[text=…]`
+//   - `A B` matches `B` inside `A`, `A > B` matches `B` directly inside `A`, and `A, B` either
+//   - `:has(B)` matches nodes containing `B`, and `:not(B)` nodes not matching `B`
+//
+// Values may be quoted e.g. `Word[text="a b"]`. Paths are dot-separated fields and indices e.g. `Stmts.0.Cmd.Args.1`.
+func Query(file *syntax.File, text string, selector string) ([]QueryMatch, error) {
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &queryNode{node: file, typ: "File"}
+	buildQueryTree(root, reflect.ValueOf(file).Elem(), "")
+
+	matches := []QueryMatch{}
+	var visit func(n *queryNode)
+	visit = func(n *queryNode) {
+		if sel.matches(n, text) {
+			matches = append(matches, QueryMatch{
+				Type: n.typ,
+				Path: n.path,
+				Pos:  mapPos(n.node.Pos()),
+				End:  mapPos(n.node.End()),
+			})
+		}
+		for _, child := range n.children {
+			visit(child)
+		}
+	}
+	visit(root)
+	return matches, nil
+}
+
+type queryNode struct {
+	node     syntax.Node
+	typ      string
+	path     string
+	parent   *queryNode
+	children []*queryNode
+}
+
+var nodeType = reflect.TypeOf((*syntax.Node)(nil)).Elem()
+
+// `buildQueryTree` adds the nodes among the fields of `v` as children of `parent`.
+func buildQueryTree(parent *queryNode, v reflect.Value, prefix string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		path := prefix + field.Name
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				addQueryNode(parent, fv.Index(j), path+"."+strconv.Itoa(j))
+			}
+			continue
+		}
+		addQueryNode(parent, fv, path)
+	}
+}
+
+func addQueryNode(parent *queryNode, v reflect.Value, path string) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	// e.g. `Comment` values, which are not walked
+	if v.Kind() != reflect.Pointer || v.IsNil() || !v.Type().Implements(nodeType) {
+		return
+	}
+	if parent.path != "" {
+		path = parent.path + "." + path
+	}
+	node := v.Interface().(syntax.Node)
+	n := &queryNode{
+		node:   node,
+		typ:    nodeTypeName(node),
+		path:   path,
+		parent: parent,
+	}
+	parent.children = append(parent.children, n)
+	buildQueryTree(n, v.Elem(), "")
+}
+
+// `nodeTypeName` is e.g. "CallExpr", as also used by mapped nodes' `Type`.
+func nodeTypeName(node syntax.Node) string {
+	return reflect.TypeOf(node).Elem().Name()
+}
+
+// `attr` returns an attribute of the node as text.
+func (n *queryNode) attr(key string, text string) (string, bool) {
+	key = strings.ToLower(key)
+	if call, ok := n.node.(*syntax.CallExpr); ok {
+		if key == "argc" {
+			return strconv.Itoa(len(call.Args)), true
+		}
+		if index, ok := strings.CutPrefix(key, "arg"); ok && isDigits(index) {
+			i, _ := strconv.Atoi(index)
+			if i < len(call.Args) {
+				return wordText(call.Args[i]), true
+			}
+			return "", false
+		}
+	}
+	if key == "text" {
+		pos, end := n.node.Pos().Offset(), n.node.End().Offset()
+		if int(end) > len(text) || pos > end {
+			return "", false
+		}
+		return text[pos:end], true
+	}
+
+	v := reflect.ValueOf(n.node).Elem()
+	field := v.FieldByNameFunc(func(name string) bool {
+		return strings.ToLower(name) == key
+	})
+	if !field.IsValid() {
+		return "", false
+	}
+	switch value := field.Interface().(type) {
+	case string:
+		return value, value != ""
+	case bool:
+		return strconv.FormatBool(value), value
+	case *syntax.Lit:
+		if value == nil {
+			return "", false
+		}
+		return value.Value, true
+	case *syntax.Word:
+		if value == nil {
+			return "", false
+		}
+		return wordText(value), true
+	case fmt.Stringer:
+		return value.String(), true
+	}
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Slice:
+		return strconv.Itoa(field.Len()), field.Len() > 0
+	case reflect.Pointer, reflect.Interface:
+		return "", !field.IsNil()
+	}
+	return "", false
+}
+
+// `selectorList` is e.g. `A B, C`.
+type selectorList []complexSelector
+
+// `complexSelector` is compounds from left to right, where `child[i]` means
+// `compounds[i+1]` is directly inside `compounds[i]`.
+type complexSelector struct {
+	compounds []compoundSelector
+	child     []bool
+}
+
+type compoundSelector struct {
+	// "" or "*" matches any node
+	typ   string
+	attrs []attrSelector
+	has   []selectorList
+	not   []selectorList
+}
+
+type attrSelector struct {
+	key, op, value string
+}
+
+func (list selectorList) matches(n *queryNode, text string) bool {
+	for _, sel := range list {
+		if sel.matchesAt(n, len(sel.compounds)-1, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func (sel complexSelector) matchesAt(n *queryNode, i int, text string) bool {
+	if !sel.compounds[i].matches(n, text) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	if sel.child[i-1] {
+		return n.parent != nil && sel.matchesAt(n.parent, i-1, text)
+	}
+	for p := n.parent; p != nil; p = p.parent {
+		if sel.matchesAt(p, i-1, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c compoundSelector) matches(n *queryNode, text string) bool {
+	if c.typ != "" && c.typ != "*" && c.typ != n.typ {
+		return false
+	}
+	for _, a := range c.attrs {
+		value, ok := n.attr(a.key, text)
+		switch a.op {
+		case "":
+			ok = ok && value != ""
+		case "=":
+			ok = ok && value == a.value
+		case "!=":
+			ok = !ok || value != a.value
+		case "^=":
+			ok = ok && strings.HasPrefix(value, a.value)
+		case "$=":
+			ok = ok && strings.HasSuffix(value, a.value)
+		case "*=":
+			ok = ok && strings.Contains(value, a.value)
+		}
+		if !ok {
+			return false
+		}
+	}
+	for _, list := range c.has {
+		if !hasDescendant(n, list, text) {
+			return false
+		}
+	}
+	for _, list := range c.not {
+		if list.matches(n, text) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasDescendant(n *queryNode, list selectorList, text string) bool {
+	for _, child := range n.children {
+		if list.matches(child, text) || hasDescendant(child, list, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// `selectorParser` is a recursive descent parser of selectors.
+type selectorParser struct {
+	src string
+	pos int
+}
+
+func parseSelector(src string) (selectorList, error) {
+	p := &selectorParser{src: src}
+	list, err := p.list()
+	if err == nil && p.pos < len(p.src) {
+		err = p.errorf("unexpected %q", p.src[p.pos])
+	}
+	return list, err
+}
+
+func (p *selectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("selector: offset %d: "+format, append([]any{p.pos}, args...)...)
+}
+
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.src) && strings.IndexByte(" \t\n", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) list() (selectorList, error) {
+	list := selectorList{}
+	for {
+		p.skipSpace()
+		sel, err := p.complex()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sel)
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		return list, nil
+	}
+}
+
+func (p *selectorParser) complex() (complexSelector, error) {
+	sel := complexSelector{}
+	for {
+		compound, err := p.compound()
+		if err != nil {
+			return sel, err
+		}
+		sel.compounds = append(sel.compounds, compound)
+
+		spaced := p.skipSpace()
+		if p.pos == len(p.src) || p.src[p.pos] == ',' || p.src[p.pos] == ')' {
+			return sel, nil
+		}
+		child := p.src[p.pos] == '>'
+		if child {
+			p.pos++
+			p.skipSpace()
+		} else if !spaced {
+			return sel, p.errorf("unexpected %q", p.src[p.pos])
+		}
+		sel.child = append(sel.child, child)
+	}
+}
+
+func (p *selectorParser) compound() (compoundSelector, error) {
+	c := compoundSelector{typ: p.ident()}
+	if c.typ == "" && p.pos < len(p.src) && p.src[p.pos] == '*' {
+		c.typ = "*"
+		p.pos++
+	}
+	start := p.pos
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '[':
+			p.pos++
+			a, err := p.attr()
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, a)
+		case ':':
+			p.pos++
+			name := p.ident()
+			if name != "has" && name != "not" {
+				return c, p.errorf("unknown pseudo-class %q", name)
+			}
+			if p.pos == len(p.src) || p.src[p.pos] != '(' {
+				return c, p.errorf("expected ( after :%s", name)
+			}
+			p.pos++
+			list, err := p.list()
+			if err != nil {
+				return c, err
+			}
+			if p.pos == len(p.src) || p.src[p.pos] != ')' {
+				return c, p.errorf("expected )")
+			}
+			p.pos++
+			if name == "has" {
+				c.has = append(c.has, list)
+			} else {
+				c.not = append(c.not, list)
+			}
+		default:
+			if c.typ == "" && p.pos == start {
+				return c, p.errorf("expected a selector")
+			}
+			return c, nil
+		}
+	}
+	if c.typ == "" && p.pos == start {
+		return c, p.errorf("expected a selector")
+	}
+	return c, nil
+}
+
+func (p *selectorParser) attr() (attrSelector, error) {
+	p.skipSpace()
+	a := attrSelector{key: p.ident()}
+	if a.key == "" {
+		return a, p.errorf("expected an attribute name")
+	}
+	p.skipSpace()
+	for _, op := range []string{"!=", "^=", "$=", "*=", "="} {
+		if strings.HasPrefix(p.src[p.pos:], op) {
+			a.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if a.op != "" {
+		p.skipSpace()
+		value, err := p.value()
+		if err != nil {
+			return a, err
+		}
+		a.value = value
+		p.skipSpace()
+	}
+	if p.pos == len(p.src) || p.src[p.pos] != ']' {
+		return a, p.errorf("expected ]")
+	}
+	p.pos++
+	return a, nil
+}
+
+// `value` is quoted, or runs until `]` e.g. `&&` or `/usr/bin`.
+func (p *selectorParser) value() (string, error) {
+	if p.pos < len(p.src) && (p.src[p.pos] == '"' || p.src[p.pos] == '\'') {
+		quote := p.src[p.pos]
+		end := strings.IndexByte(p.src[p.pos+1:], quote)
+		if end == -1 {
+			return "", p.errorf("unterminated string")
+		}
+		value := p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return value, nil
+	}
+	end := strings.IndexByte(p.src[p.pos:], ']')
+	if end == -1 {
+		return "", p.errorf("expected ]")
+	}
+	value := strings.TrimSpace(p.src[p.pos : p.pos+end])
+	p.pos += end
+	return value, nil
+}
+
+func (p *selectorParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '_' || c == '-' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.src[start:p.pos]
+}
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"errors"
+	"strings"
+
+	"mvdan.cc/sh/v3/expand"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Quote` quotes each value for the given variant, so that it reparses as a single
+// word expanding to the original value e.g. an NPC name with spaces or a JSON blob.
+//
+// Each quoted value is checked by reparsing it. Values which cannot be quoted,
+// such as those containing NUL bytes, are reported individually.
+func Quote(values []string, variant syntax.LangVariant) QuoteResult {
+	result := QuoteResult{Values: make([]QuotedValue, len(values))}
+	words := make([]string, 0, len(values))
+
+	for i, value := range values {
+		quoted, err := syntax.Quote(value, variant)
+		if err == nil {
+			err = checkQuoted(quoted, value, variant)
+		}
+		if err != nil {
+			result.Values[i] = QuotedValue{Error: mapQuoteError(err)}
+			continue
+		}
+		result.Values[i] = QuotedValue{Value: quoted, Form: quoteForm(quoted, value)}
+		words = append(words, quoted)
+	}
+
+	if len(words) == len(values) {
+		result.Line = strings.Join(words, " ")
+	}
+	return result
+}
+
+// `quoteForm` is one of "none", "single" i.e. '…', "double" i.e. "…", or "ansi" i.e. $'…'.
+func quoteForm(quoted, value string) string {
+	switch {
+	case quoted == value:
+		return "none"
+	case strings.HasPrefix(quoted, "$'"):
+		return "ansi"
+	case strings.HasPrefix(quoted, "'"):
+		return "single"
+	default:
+		return "double"
+	}
+}
+
+// `checkQuoted` verifies that `quoted` parses as one word which expands to `value`.
+func checkQuoted(quoted, value string, variant syntax.LangVariant) error {
+	var words []*syntax.Word
+	err := syntax.NewParser(syntax.Variant(variant)).Words(strings.NewReader(quoted), func(word *syntax.Word) bool {
+		words = append(words, word)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if len(words) != 1 {
+		return errors.New("quoted value does not reparse as a single word")
+	}
+	expanded, err := expand.Literal(nil, words[0])
+	if err != nil {
+		return err
+	}
+	if expanded != value {
+		return errors.New("quoted value does not reparse to the original")
+	}
+	return nil
+}
+
+func mapQuoteError(err error) *QuoteError {
+	var quoteErr *syntax.QuoteError
+	if errors.As(err, &quoteErr) {
+		return &QuoteError{ByteOffset: quoteErr.ByteOffset, Message: quoteErr.Message}
+	}
+	return &QuoteError{ByteOffset: -1, Message: err.Error()}
+}
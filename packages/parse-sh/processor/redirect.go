@@ -0,0 +1,187 @@
+package processor
+
+import (
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `mapRedirectFd` describes what a redirect does to file descriptors, so the runtime
+// need not interpret `Op`, `N` and `Word` itself.
+func mapRedirectFd(r *syntax.Redirect) RedirectFd {
+	fd := RedirectFd{Fd: 1, TargetFd: -1}
+	switch r.Op {
+	case syntax.RdrIn, syntax.RdrInOut, syntax.DplIn, syntax.Hdoc, syntax.DashHdoc, syntax.WordHdoc:
+		fd.Fd = 0
+	}
+	if r.N != nil {
+		if name, ok := strings.CutPrefix(r.N.Value, "{"); ok {
+			// `{varname}>out` allocates an fd stored in `varname`
+			fd.FdVar = strings.TrimSuffix(name, "}")
+			fd.Fd = -1
+		} else if n, err := strconv.Atoi(r.N.Value); err == nil {
+			fd.Fd = n
+		}
+	}
+
+	switch r.Op {
+	case syntax.RdrOut:
+		fd.Kind = "output"
+	case syntax.AppOut:
+		fd.Kind = "append"
+	case syntax.ClbOut:
+		fd.Kind = "clobber"
+	case syntax.RdrIn:
+		fd.Kind = "input"
+	case syntax.RdrInOut:
+		fd.Kind = "input-output"
+	case syntax.RdrAll:
+		fd.Kind, fd.Stderr = "output", true
+	case syntax.AppAll:
+		fd.Kind, fd.Stderr = "append", true
+	case syntax.Hdoc, syntax.DashHdoc:
+		fd.Kind = "heredoc"
+	case syntax.WordHdoc:
+		fd.Kind = "herestring"
+	case syntax.DplIn, syntax.DplOut:
+		fd.Kind = "dup-input"
+		if r.Op == syntax.DplOut {
+			fd.Kind = "dup-output"
+		}
+		target := r.Word.Lit()
+		switch n, move := strings.CutSuffix(target, "-"); {
+		case target == "-":
+			fd.Kind = "close"
+		case target == "":
+			// e.g. `>&$fd`, only known at runtime
+		case isDigits(n):
+			fd.TargetFd, _ = strconv.Atoi(n)
+			fd.Move = move
+		case r.Op == syntax.DplOut && r.N == nil:
+			// `>&file` is `&>file`
+			fd.Kind, fd.Stderr = "output", true
+		}
+	}
+	return fd
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// `mapHeredoc` returns metadata for `<<` and `<<-`, else nil.
+// An empty body has nil ranges unless `fillEmptyHeredocs` ran, because the parser provides no `Hdoc` word.
+func mapHeredoc(r *syntax.Redirect) *Heredoc {
+	if r.Op != syntax.Hdoc && r.Op != syntax.DashHdoc {
+		return nil
+	}
+
+	delimiter, ok := staticString(r.Word)
+	if !ok {
+		delimiter = wordText(r.Word)
+	}
+	heredoc := &Heredoc{
+		Delimiter: delimiter,
+		Quoted:    !isUnquotedLit(r.Word),
+		StripTabs: r.Op == syntax.DashHdoc,
+	}
+	if r.Hdoc == nil || !r.Hdoc.Pos().IsValid() {
+		return heredoc
+	}
+
+	// the word ends after the terminator, which is on its own line
+	end := r.Hdoc.End()
+	terminator := mapPos(end)
+	terminator.Offset -= uint(len(delimiter))
+	terminator.Col -= uint(len(delimiter))
+	lineStart := terminator
+	lineStart.Offset -= terminator.Col - 1
+	lineStart.Col = 1
+
+	heredoc.BodyPos = mapNullablePos(r.Hdoc.Pos())
+	heredoc.BodyEnd = &lineStart
+	heredoc.TerminatorPos = &terminator
+	heredoc.TerminatorEnd = mapNullablePos(end)
+
+	var sb strings.Builder
+	for _, part := range r.Hdoc.Parts {
+		if lit, ok := part.(*syntax.Lit); ok {
+			sb.WriteString(lit.Value)
+		} else {
+			sb.WriteString(wordText(&syntax.Word{Parts: []syntax.WordPart{part}}))
+		}
+	}
+	body := sb.String()
+	// drop the terminator's indentation
+	if i := strings.LastIndexByte(body, '\n'); i >= 0 {
+		body = body[:i+1]
+	} else {
+		body = ""
+	}
+	if heredoc.StripTabs {
+		lines := strings.SplitAfter(body, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimLeft(line, "\t")
+		}
+		body = strings.Join(lines, "")
+	}
+	heredoc.Body = body
+	return heredoc
+}
+
+// `isUnquotedLit` holds for words without quotes or escapes, whose heredocs are expanded.
+func isUnquotedLit(word *syntax.Word) bool {
+	for _, part := range word.Parts {
+		lit, ok := part.(*syntax.Lit)
+		if !ok || strings.Contains(lit.Value, `\`) {
+			return false
+		}
+	}
+	return true
+}
+
+// `fillEmptyHeredocs` gives each heredoc with an empty body e.g. `cat <<EOF` followed by `EOF`
+// an empty `Hdoc` word ranging over its terminator, as the parser does for `<<-` with tabs.
+// Bodies are read in order, starting on the line after the operator or the previous body.
+func fillEmptyHeredocs(node syntax.Node, text string) {
+	next := uint(0)
+	syntax.Walk(node, func(node syntax.Node) bool {
+		r, ok := node.(*syntax.Redirect)
+		if !ok || r.Op != syntax.Hdoc && r.Op != syntax.DashHdoc {
+			return true
+		}
+		if r.Hdoc != nil {
+			next = max(next, r.Hdoc.End().Offset())
+			return true
+		}
+		start := max(next, r.Word.End().Offset())
+		if start > uint(len(text)) {
+			return true
+		}
+		i := strings.IndexByte(text[start:], '\n')
+		if i < 0 {
+			return true
+		}
+		lineStart := start + uint(i) + 1
+		line := text[lineStart:]
+		if r.Op == syntax.DashHdoc {
+			line = strings.TrimLeft(line, "\t")
+		}
+		delimiter, ok := staticString(r.Word)
+		if !ok {
+			delimiter = wordText(r.Word)
+		}
+		if !strings.HasPrefix(line, delimiter) {
+			return true
+		}
+		end := lineStart + uint(len(text)-int(lineStart)-len(line)+len(delimiter))
+		lineNum := uint(strings.Count(text[:lineStart], "\n")) + 1
+		r.Hdoc = &syntax.Word{Parts: []syntax.WordPart{&syntax.Lit{
+			ValuePos: syntax.NewPos(lineStart, lineNum, 1),
+			ValueEnd: syntax.NewPos(end, lineNum, end-lineStart+1),
+		}}}
+		next = end
+		return true
+	})
+}
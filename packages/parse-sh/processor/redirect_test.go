@@ -0,0 +1,45 @@
+package processor
+
+import (
+	"testing"
+)
+
+func TestHeredoc(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// index of the heredoc's statement
+		stmt       int
+		body       string
+		terminator string
+	}{
+		{"body", "cat <<EOF\na\nEOF\n", 0, "a\n", "EOF"},
+		{"empty", "cat <<EOF\nEOF\necho", 0, "", "EOF"},
+		{"empty at end", "cat <<EOF\nEOF", 0, "", "EOF"},
+		{"empty quoted", "cat <<'EOF'\nEOF\n", 0, "", "EOF"},
+		{"empty tabs", "cat <<-EOF\n\t\tEOF\n", 0, "", "EOF"},
+		{"empty after body", "cat <<A; cat <<B\na\nA\nB\n", 1, "", "B"},
+		{"body after empty", "cat <<A <<B\nA\nb\nB\n", 0, "", "A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			heredoc := MapFile(*file).Stmts[tt.stmt].Redirs[0].Heredoc
+			if heredoc.Body != tt.body {
+				t.Errorf("body = %q, want %q", heredoc.Body, tt.body)
+			}
+			if heredoc.TerminatorPos == nil || heredoc.BodyPos == nil {
+				t.Fatalf("nil ranges")
+			}
+			if terminator := tt.text[heredoc.TerminatorPos.Offset:heredoc.TerminatorEnd.Offset]; terminator != tt.terminator {
+				t.Errorf("terminator = %q, want %q", terminator, tt.terminator)
+			}
+			if body := tt.text[heredoc.BodyPos.Offset:heredoc.BodyEnd.Offset]; body != tt.body {
+				t.Errorf("body range = %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
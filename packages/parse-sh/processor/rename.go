@@ -0,0 +1,176 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Rename` renames the variable or function whose name contains byte `offset`, returning
+// an edit per definition and reference, so formatting and comments are preserved.
+func Rename(file *syntax.File, offset uint, newName string) RenameResult {
+	table := Symbols(file)
+	result := RenameResult{Edits: []TextEdit{}}
+
+	contains := func(ref SymbolRef) bool {
+		return ref.Pos.Offset <= offset && offset <= ref.End.Offset
+	}
+	var refs []SymbolRef
+
+	for _, vr := range table.Variables {
+		if slices.ContainsFunc(vr.Defs, contains) || slices.ContainsFunc(vr.Refs, contains) {
+			result.Kind, result.OldName = "variable", vr.Name
+			refs = append(slices.Clone(vr.Defs), vr.Refs...)
+			if slices.ContainsFunc(table.Variables, func(other SymbolVariable) bool {
+				return other.Name == newName && other.Scope == vr.Scope
+			}) {
+				result.Error = &RenameError{Kind: "Conflict", Message: "variable " + newName + " already exists in this scope"}
+			} else if !resolvesAlike(file, refs, newName) {
+				result.Error = &RenameError{Kind: "Conflict", Message: "variable " + newName + " would capture or be captured by references to " + vr.Name}
+			}
+			break
+		}
+	}
+
+	if result.Kind == "" {
+		operands := functionOperands(file)
+		name := ""
+		for _, lit := range operands {
+			if contains(SymbolRef{Pos: mapPos(lit.Pos()), End: mapPos(lit.End())}) &&
+				slices.ContainsFunc(table.Functions, func(fn SymbolFunction) bool { return fn.Name == lit.Value }) {
+				name = lit.Value
+			}
+		}
+		for _, fn := range table.Functions {
+			if contains(SymbolRef{Pos: fn.Pos, End: fn.End}) {
+				name = fn.Name
+			}
+		}
+		for _, call := range table.Calls {
+			if contains(SymbolRef{Pos: call.Pos, End: call.End}) {
+				name = call.Name
+			}
+		}
+		if name != "" {
+			result.Kind, result.OldName = "function", name
+			for _, fn := range table.Functions {
+				switch fn.Name {
+				case name:
+					refs = append(refs, SymbolRef{Pos: fn.Pos, End: fn.End})
+				case newName:
+					result.Error = &RenameError{Kind: "Conflict", Message: "function " + newName + " already exists"}
+				}
+			}
+			for _, call := range table.Calls {
+				if call.Name == name {
+					refs = append(refs, SymbolRef{Pos: call.Pos, End: call.End})
+				}
+			}
+			for _, lit := range operands {
+				if lit.Value == name {
+					refs = append(refs, SymbolRef{Pos: mapPos(lit.Pos()), End: mapPos(lit.End())})
+				}
+			}
+		}
+	}
+
+	switch {
+	case result.Kind == "":
+		result.Error = &RenameError{Kind: "NoSymbol", Message: "no variable or function at offset"}
+	case !syntax.ValidName(newName):
+		result.Error = &RenameError{Kind: "InvalidName", Message: "invalid name: " + newName}
+	}
+	if result.Error != nil {
+		return result
+	}
+
+	// e.g. `x` in `(( x++ ))` is both a definition and a reference
+	slices.SortFunc(refs, func(a, b SymbolRef) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	refs = slices.CompactFunc(refs, func(a, b SymbolRef) bool {
+		return a.Pos.Offset == b.Pos.Offset
+	})
+	for _, ref := range refs {
+		result.Edits = append(result.Edits, TextEdit{Pos: ref.Pos, End: ref.End, NewText: newName})
+	}
+	return result
+}
+
+// `resolvesAlike` checks the definitions and references `refs` of a variable still resolve to one
+// variable once renamed to `newName`, and that no other site resolves to it e.g. due to a `local`
+// of that name. The names are renamed in place while the symbols are collected again.
+func resolvesAlike(file *syntax.File, refs []SymbolRef, newName string) bool {
+	renamed := map[uint]bool{}
+	for _, ref := range refs {
+		renamed[ref.Pos.Offset] = true
+	}
+	lits := map[*syntax.Lit]string{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if lit, ok := node.(*syntax.Lit); ok && renamed[lit.Pos().Offset()] {
+			lits[lit] = lit.Value
+			lit.Value = newName
+		}
+		return true
+	})
+	defer func() {
+		for lit, value := range lits {
+			lit.Value = value
+		}
+	}()
+
+	for _, vr := range Symbols(file).Variables {
+		sites := map[uint]bool{}
+		for _, site := range append(slices.Clone(vr.Defs), vr.Refs...) {
+			sites[site.Pos.Offset] = true
+		}
+		shared := 0
+		for offset := range sites {
+			if renamed[offset] {
+				shared++
+			}
+		}
+		if shared > 0 && (shared < len(sites) || shared < len(renamed)) {
+			return false
+		}
+	}
+	return true
+}
+
+// `functionOperands` finds the names of functions in e.g. `unset -f f`, `declare -f f` or `export -f f`.
+func functionOperands(file *syntax.File) []*syntax.Lit {
+	lits := []*syntax.Lit{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.CallExpr:
+			if len(node.Args) == 0 || node.Args[0].Lit() != "unset" {
+				break
+			}
+			functions := false
+			for _, arg := range node.Args[1:] {
+				switch lit := arg.Lit(); {
+				case strings.HasPrefix(lit, "-") && len(lit) > 1:
+					// the last of `-f` and `-v` applies
+					if i := strings.LastIndexAny(lit, "fv"); i > 0 {
+						functions = lit[i] == 'f'
+					}
+				case functions && syntax.ValidName(lit):
+					lits = append(lits, arg.Parts[0].(*syntax.Lit))
+				}
+			}
+		case *syntax.DeclClause:
+			functions := false
+			for _, assign := range node.Args {
+				if assign.Name == nil {
+					opts := assign.Value.Lit()
+					functions = functions || strings.HasPrefix(opts, "-") && strings.ContainsAny(opts, "fF")
+				} else if functions && assign.Naked {
+					lits = append(lits, assign.Name)
+				}
+			}
+		}
+		return true
+	})
+	return lits
+}
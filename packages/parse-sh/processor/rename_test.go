@@ -0,0 +1,85 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenameFunction(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// the symbol to rename is the first `f`, or that after `@`
+		want string
+	}{
+		{"unset", "f() { :; }; f; unset -f f", "g() { :; }; g; unset -f g"},
+		{"unset from operand", "f() { :; }; unset -f @f", "g() { :; }; unset -f g"},
+		{"unset variable", "f() { :; }; unset f; unset -v f", "g() { :; }; unset f; unset -v f"},
+		{"declare", "f() { :; }; declare -f f; typeset -F f; export -f f", "g() { :; }; declare -f g; typeset -F g; export -f g"},
+		{"declare variable", "f() { :; }; declare f=1", "g() { :; }; declare f=1"},
+		{"not a function", "unset -f @f", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := strings.Index(tt.text, "@")
+			text := strings.Replace(tt.text, "@", "", 1)
+			if offset < 0 {
+				offset = strings.Index(text, "f")
+			}
+			file, err := Parse(text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := Rename(file, uint(offset), "g")
+			if tt.want == "" {
+				if result.Error == nil {
+					t.Fatalf("edits = %+v, want an error", result.Edits)
+				}
+				return
+			}
+			if result.Error != nil {
+				t.Fatal(result.Error.Message)
+			}
+			if got := applyEdits(text, result.Edits); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenameVariable(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// the symbol to rename is the first `x`, renamed to `y`
+		want string
+	}{
+		{"global", "x=1; echo $x", "y=1; echo $y"},
+		{"local", "f() { local x=1; echo $x; }; echo $x", "f() { local y=1; echo $y; }; echo $x"},
+		{"same scope", "x=1; y=2", ""},
+		{"captured by a local", "x=1; f() { local y=2; echo $x $y; }", ""},
+		{"capturing a global", "y=1; f() { local x=2; echo $x $y; }", ""},
+		{"local elsewhere", "x=1; f() { local y=2; echo $y; }; echo $x", "y=1; f() { local y=2; echo $y; }; echo $y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := Rename(file, uint(strings.Index(tt.text, "x")), "y")
+			if tt.want == "" {
+				if result.Error == nil {
+					t.Fatalf("edits = %+v, want an error", result.Edits)
+				}
+				return
+			}
+			if result.Error != nil {
+				t.Fatal(result.Error.Message)
+			}
+			if got := applyEdits(tt.text, result.Edits); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
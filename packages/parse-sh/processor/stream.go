@@ -0,0 +1,240 @@
+package processor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `StmtStream` parses top-level statements on demand via `Parser.Stmts`, so earlier statements
+// can run before later ones are parsed, and a syntax error is only reported once reached.
+//
+// Each `Next` resumes parsing from the first statement not yet returned. Parsing stops once a
+// statement follows every pending heredoc body e.g. `cat <<EOF; echo` is parsed along with `echo`.
+//
+// Aliases and limits apply as in `Parse`, except that the node, depth, step, time and output
+// limits are per statement. Positions are in the text with aliases expanded so far.
+type StmtStream struct {
+	text          string
+	filepath      string
+	options       []syntax.ParserOption
+	parserOptions ParserOptions
+	// where parsing resumes, and the same position in the text with aliases expanded so far
+	in, out textPos
+	pending []*syntax.Stmt
+	aliases []AliasExpansion
+	// of positions of `pending` and `aliases`
+	shift textShift
+	err   error
+	done  bool
+}
+
+// `textPos` is a position in a text along with the start of its line.
+type textPos struct {
+	offset, line, lineStart int
+}
+
+// `shift` relates positions of the text after `p` to the whole text.
+func (p textPos) shift() textShift {
+	return textShift{offset: p.offset, line: p.line - 1, col: p.offset - p.lineStart}
+}
+
+// `advance` moves `p` past `text`.
+func (p textPos) advance(text string) textPos {
+	if i := strings.LastIndexByte(text, '\n'); i >= 0 {
+		p.line += strings.Count(text, "\n")
+		p.lineStart = p.offset + i + 1
+	}
+	p.offset += len(text)
+	return p
+}
+
+func NewStmtStream(text string, filepath string, parserOptions ParserOptions) *StmtStream {
+	s := &StmtStream{
+		text:          text,
+		filepath:      filepath,
+		parserOptions: parserOptions,
+		in:            textPos{line: 1},
+		out:           textPos{line: 1},
+	}
+	variant, _ := ResolveVariant(text, filepath, parserOptions.Variant)
+	s.parserOptions.Variant = variant
+	s.options = append(s.options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(variant))
+	if parserOptions.StopAt != "" {
+		s.options = append(s.options, syntax.StopAt(parserOptions.StopAt))
+	}
+	if err := checkInput(text, parserOptions.Limits); err != nil {
+		s.err, s.done = err, true
+	}
+	return s
+}
+
+// `Next` returns the next top-level statement, else `Done` along with any syntax or limit error.
+func (s *StmtStream) Next() StmtResult {
+	if len(s.pending) == 0 && !s.done {
+		s.parse()
+	}
+	if len(s.pending) == 0 {
+		result := StmtResult{Done: true, Aliases: []AliasExpansion{}}
+		if limitErr, ok := s.err.(*LimitError); ok {
+			result.LimitError, result.Message = limitErr, limitErr.Error()
+			return result
+		}
+		result.ParseError, result.Message = MapParseError(s.err)
+		return result
+	}
+
+	stmt := s.pending[0]
+	s.pending = s.pending[1:]
+	posShift = s.shift
+	defer func() { posShift = textShift{} }()
+
+	var mapped Stmt
+	err := withBudget(s.parserOptions.Limits, func() { mapped = mapStmt(stmt) })
+	result := StmtResult{Stmt: &mapped, Pos: mapNullablePos(stmt.Pos()), End: mapNullablePos(stmt.End()), Aliases: []AliasExpansion{}}
+	if err == nil {
+		result.Aliases = s.stmtAliases(result.Pos, result.End)
+		err = checkOutput(&result, s.parserOptions.Limits)
+	}
+	if err != nil {
+		s.pending, s.err, s.done = nil, err, true
+		return StmtResult{Done: true, Aliases: []AliasExpansion{}, LimitError: err, Message: err.Error()}
+	}
+	return result
+}
+
+// `checkOutput` bounds the size of a statement's JSON result, measured by marshalling it.
+func checkOutput(result *StmtResult, limits ParseLimits) *LimitError {
+	if limits.MaxOutputBytes <= 0 {
+		return nil
+	}
+	bytes, err := easyjson.Marshal(result)
+	if err != nil || len(bytes) <= limits.MaxOutputBytes {
+		return nil
+	}
+	return limitError("OutputBytes", limits.MaxOutputBytes, "output of "+strconv.Itoa(len(bytes))+" bytes")
+}
+
+// `stmtAliases` returns the pending expansions within a statement's range, re-indexing parents.
+func (s *StmtStream) stmtAliases(pos, end *Pos) []AliasExpansion {
+	found := []AliasExpansion{}
+	index := map[int]int{}
+	for i, exp := range s.aliases {
+		if pos == nil || end == nil || exp.Pos.Offset < pos.Offset || exp.End.Offset > end.Offset {
+			continue
+		}
+		if parent, ok := index[exp.Parent]; ok {
+			exp.Parent = parent
+		} else {
+			exp.Parent = -1
+		}
+		index[i] = len(found)
+		found = append(found, exp)
+	}
+	return found
+}
+
+// `parse` fills `pending` with the next statements, stopping before the first statement
+// which can be parsed independently. Only the remaining text is parsed, without copying it.
+func (s *StmtStream) parse() {
+	rest := s.text[s.in.offset:]
+
+	pending := []*syntax.Stmt{}
+	resume := -1
+	err := syntax.NewParser(s.options...).Stmts(strings.NewReader(rest), func(stmt *syntax.Stmt) bool {
+		start := int(stmt.Pos().Offset())
+		if len(stmt.Comments) > 0 {
+			start = min(start, int(stmt.Comments[0].Pos().Offset()))
+		}
+		if len(pending) > 0 && start >= heredocEnd(pending, rest) {
+			resume = start
+			return false
+		}
+		pending = append(pending, stmt)
+		return true
+	})
+
+	chunk := rest
+	switch {
+	case err != nil:
+		// the statements before the error are still returned
+		chunk = rest[:0]
+		if len(pending) > 0 {
+			chunk = rest[:max(int(pending[len(pending)-1].End().Offset()), heredocEnd(pending, rest))]
+		}
+	case resume != -1:
+		chunk = rest[:resume]
+	}
+	if expandErr := s.expand(pending, chunk); expandErr != nil {
+		s.pending, s.aliases, s.err, s.done = nil, nil, expandErr, true
+		return
+	}
+
+	// `Parser.Stmts` lacks a file name, and positions are relative to `rest`
+	if parseErr, ok := err.(syntax.ParseError); ok {
+		after := s.text[s.in.offset:]
+		offset := int(parseErr.Pos.Offset()) - len(chunk)
+		pos := s.out.shift().pos(0, 1, 1)
+		if offset >= 0 && offset <= len(after) {
+			pos = s.out.advance(after[:offset]).shift().pos(0, 1, 1)
+		}
+		parseErr.Filename = s.filepath
+		parseErr.Pos = syntax.NewPos(pos.Offset, pos.Line, pos.Col)
+		err = parseErr
+	}
+	switch {
+	case err != nil:
+		s.err, s.done = err, true
+	case resume == -1:
+		s.done = true
+	}
+}
+
+// `expand` expands aliases of statements parsed from `chunk`, which starts at `s.in`,
+// checks their limits, and advances past `chunk`.
+func (s *StmtStream) expand(stmts []*syntax.Stmt, chunk string) error {
+	file := &syntax.File{Name: s.filepath, Stmts: stmts}
+	heredocEnd(stmts, chunk)
+	file, expanded, expansions, err := ExpandAliases(file, chunk, s.filepath, s.parserOptions)
+	if parseErr, ok := err.(syntax.ParseError); ok {
+		pos := s.out.shift().pos(parseErr.Pos.Offset(), parseErr.Pos.Line(), parseErr.Pos.Col())
+		parseErr.Pos = syntax.NewPos(pos.Offset, pos.Line, pos.Col)
+		return parseErr
+	}
+	if err != nil {
+		return err
+	}
+	if limitErr := checkFile(file, s.parserOptions.Limits); limitErr != nil {
+		return limitErr
+	}
+
+	inShift, outShift := s.in.shift(), s.out.shift()
+	for i := range expansions {
+		exp := &expansions[i]
+		exp.Pos = outShift.pos(exp.Pos.Offset, exp.Pos.Line, exp.Pos.Col)
+		exp.End = outShift.pos(exp.End.Offset, exp.End.Line, exp.End.Col)
+		exp.OrigPos = inShift.pos(exp.OrigPos.Offset, exp.OrigPos.Line, exp.OrigPos.Col)
+		exp.OrigEnd = inShift.pos(exp.OrigEnd.Offset, exp.OrigEnd.Line, exp.OrigEnd.Col)
+	}
+	s.pending, s.aliases, s.shift = file.Stmts, expansions, outShift
+	s.in, s.out = s.in.advance(chunk), s.out.advance(expanded)
+	return nil
+}
+
+// `heredocEnd` is the offset after the heredoc bodies of `stmts` parsed from `text`, else 0.
+// Bodies are only parsed at the end of their line, i.e. after their statement was reported.
+func heredocEnd(stmts []*syntax.Stmt, text string) int {
+	fillEmptyHeredocs(&syntax.File{Stmts: stmts}, text)
+	end := 0
+	for _, stmt := range stmts {
+		syntax.Walk(stmt, func(node syntax.Node) bool {
+			if r, ok := node.(*syntax.Redirect); ok && r.Hdoc != nil {
+				end = max(end, int(r.Hdoc.End().Offset()))
+			}
+			return true
+		})
+	}
+	return end
+}
@@ -0,0 +1,91 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+// `streamAll` returns the text of each statement of a stream, and its final result.
+func streamAll(t *testing.T, text string, options ParserOptions) ([]StmtResult, StmtResult) {
+	t.Helper()
+	stream := NewStmtStream(text, "", options)
+	results := []StmtResult{}
+	for range 100 {
+		result := stream.Next()
+		if result.Done {
+			return results, result
+		}
+		results = append(results, result)
+	}
+	t.Fatal("stream did not finish")
+	return nil, StmtResult{}
+}
+
+func TestStmtStreamPositions(t *testing.T) {
+	text := "echo a; echo b\n\nif true; then\n  cat <<EOF; echo c\nbody\nEOF\nfi\ncat <<X; echo d\nX\necho e"
+	file, err := Parse(text, "", ParserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, last := streamAll(t, text, ParserOptions{})
+	if last.Message != "" {
+		t.Fatal(last.Message)
+	}
+	if len(results) != len(file.Stmts) {
+		t.Fatalf("got %d statements, want %d", len(results), len(file.Stmts))
+	}
+	for i, result := range results {
+		if want := mapPos(file.Stmts[i].Pos()); *result.Pos != want {
+			t.Errorf("statement %d: pos = %+v, want %+v", i, *result.Pos, want)
+		}
+		if want := mapPos(file.Stmts[i].End()); *result.End != want {
+			t.Errorf("statement %d: end = %+v, want %+v", i, *result.End, want)
+		}
+	}
+	// the empty heredoc keeps `echo d` with `cat`, so positions match `Parse`
+	if want := strings.Index(text, "echo d"); int(results[4].Pos.Offset) != want {
+		t.Errorf("echo d at %d, want %d", results[4].Pos.Offset, want)
+	}
+}
+
+func TestStmtStreamError(t *testing.T) {
+	text := "echo a\necho b\n  echo )"
+	results, last := streamAll(t, text, ParserOptions{})
+	if len(results) != 2 || last.ParseError == nil {
+		t.Fatalf("got %d statements and %+v", len(results), last)
+	}
+	if pos := last.ParseError.Pos; pos.Line != 3 || pos.Col != 8 || pos.Offset != uint(strings.Index(text, ")")) {
+		t.Errorf("error at %+v", pos)
+	}
+}
+
+func TestStmtStreamAliases(t *testing.T) {
+	options := ParserOptions{Aliases: map[string]string{"ll": "ls -l"}}
+	results, last := streamAll(t, "ll; ll a\nll", options)
+	if last.Message != "" || len(results) != 3 {
+		t.Fatalf("got %d statements and %+v", len(results), last)
+	}
+	// in the expanded text "ls -l; ls -l a\nls -l"
+	wants := []struct{ pos, line, col, origPos uint }{{0, 1, 1, 0}, {7, 1, 8, 4}, {15, 2, 1, 9}}
+	for i, want := range wants {
+		result := results[i]
+		if result.Pos.Offset != want.pos || result.Pos.Line != want.line || result.Pos.Col != want.col {
+			t.Errorf("statement %d at %+v", i, *result.Pos)
+		}
+		if len(result.Aliases) != 1 || result.Aliases[0].OrigPos.Offset != want.origPos || result.Aliases[0].Pos.Offset != want.pos {
+			t.Errorf("statement %d aliases = %+v", i, result.Aliases)
+		}
+	}
+}
+
+func TestStmtStreamLimits(t *testing.T) {
+	text := "echo a\necho $(echo $(echo $(echo b)))"
+	results, last := streamAll(t, text, ParserOptions{Limits: ParseLimits{MaxNodes: 10}})
+	if len(results) != 1 || last.LimitError == nil || last.LimitError.Kind != "Nodes" {
+		t.Errorf("got %d statements and %+v", len(results), last)
+	}
+	results, last = streamAll(t, text, ParserOptions{Limits: ParseLimits{MaxOutputBytes: 2000}})
+	if len(results) != 1 || last.LimitError == nil || last.LimitError.Kind != "OutputBytes" {
+		t.Errorf("got %d statements and %+v", len(results), last)
+	}
+}
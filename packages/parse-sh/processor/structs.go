@@ -6,6 +6,8 @@ import (
 
 type ArithmCmd struct {
 	Type string
+	ID int
+	ParentID int
 	Unsigned bool // mksh's ((# expr))
 	X interface{} // ArithmExpr
 	Left Pos
@@ -26,6 +28,8 @@ func (Word) arithmExprNode() {}
 
 type ArrayElem struct {
 	Type string
+	ID int
+	ParentID int
 	Index interface{} // ArithmExpr
 	Value Word
 	Comments []Comment
@@ -35,6 +39,8 @@ type ArrayElem struct {
 
 type ArrayExpr struct {
 	Type string
+	ID int
+	ParentID int
 	Elems []ArrayElem
 	Lparen Pos
 	Rparen Pos
@@ -45,6 +51,8 @@ type ArrayExpr struct {
 
 type Assign struct {
 	Type string
+	ID int
+	ParentID int
 	Append bool
 	Naked bool
 	Name *Lit
@@ -57,6 +65,8 @@ type Assign struct {
 
 type BinaryCmd struct {
 	Type string
+	ID int
+	ParentID int
 	X Stmt
 	Y Stmt
 	Op string
@@ -67,6 +77,8 @@ type BinaryCmd struct {
 
 type BinaryTest struct {
 	Type 	string
+	ID int
+	ParentID int
 	Op 		string
 	X  		interface{} // TestExpr
 	Y  		interface{} // TestExpr
@@ -77,6 +89,8 @@ type BinaryTest struct {
 
 type Block struct {
 	Type string
+	ID int
+	ParentID int
 	Stmts []Stmt
 	Lbrace Pos
 	Rbrace Pos
@@ -87,6 +101,8 @@ type Block struct {
 
 type CallExpr struct {
 	Type string
+	ID int
+	ParentID int
 	Assigns []Assign
 	Args []Word
 	Pos Pos
@@ -95,6 +111,8 @@ type CallExpr struct {
 
 type CaseClause struct {
 	Type string
+	ID int
+	ParentID int
 	Word Word
 	Items []CaseItem
 	Case Pos
@@ -106,6 +124,8 @@ type CaseClause struct {
 
 type CaseItem struct {
 	Type string
+	ID int
+	ParentID int
 	Op string
 	Patterns []Word
 	Stmts []Stmt
@@ -117,6 +137,8 @@ type CaseItem struct {
 
 type CmdSubst struct {
 	Type string
+	ID int
+	ParentID int
 	TempFile bool
 	ReplyVar bool
 	Stmts []Stmt
@@ -149,6 +171,8 @@ func (Unhandled) commandNode() {}
 func (WhileClause) commandNode() {}
 
 type Comment struct {
+	ID int
+	ParentID int
 	Text string
 	Hash Pos
 	Pos  Pos
@@ -157,6 +181,8 @@ type Comment struct {
 
 type CoprocClause struct {
 	Type string
+	ID int
+	ParentID int
 	Name *Word
 	Stmt Stmt
 	Coproc Pos;
@@ -166,6 +192,8 @@ type CoprocClause struct {
 
 type CStyleLoop struct {
 	Type string
+	ID int
+	ParentID int
 	Init interface{} // ArithmExpr
 	Cond interface{} // ArithmExpr
 	Post interface{} // ArithmExpr
@@ -175,6 +203,8 @@ type CStyleLoop struct {
 
 type DblQuoted struct {
 	Type string
+	ID int
+	ParentID int
 	Dollar bool
 	Parts interface{} // WordPart
 	Left Pos
@@ -185,6 +215,8 @@ type DblQuoted struct {
 
 type DeclClause struct {
 	Type string
+	ID int
+	ParentID int
 	Variant Lit
 	Args []Assign
 	Pos Pos
@@ -193,6 +225,8 @@ type DeclClause struct {
 
 type Expansion struct {
 	Type string
+	ID int
+	ParentID int
 	Op string
 	Word Word
 	// Pos Pos
@@ -201,6 +235,9 @@ type Expansion struct {
 
 type File struct {
 	Type string
+	// pre-order index of each node, see `numberNodes`
+	ID int
+	ParentID int
 	Name string
 	Stmts []Stmt
 	Last []Comment
@@ -210,6 +247,8 @@ type File struct {
 
 type ForClause struct {
 	Type string
+	ID int
+	ParentID int
 	Select bool
 	// interface type processor.Loop not supported: only interface{} and easyjson/json Unmarshaler are allowed
 	Loop interface{} // Loop
@@ -223,6 +262,8 @@ type ForClause struct {
 
 type FuncDecl struct {
 	Type string
+	ID int
+	ParentID int
 	RsrvWord bool
 	Name Lit
 	Body Stmt
@@ -233,6 +274,8 @@ type FuncDecl struct {
 
 type IfClause struct {
 	Type string
+	ID int
+	ParentID int
 	/* empty for an "else" */
 	Cond []Stmt;
 	Then []Stmt;
@@ -249,6 +292,8 @@ type IfClause struct {
 
 type LetClause struct {
 	Type 	string
+	ID int
+	ParentID int
 	Exprs interface{} // []ArithmExpr
 	Let Pos
 	Pos Pos
@@ -256,6 +301,8 @@ type LetClause struct {
 }
 
 type Lit struct {
+	ID int
+	ParentID int
 	Value    string
 	ValuePos Pos
 	ValueEnd Pos
@@ -276,6 +323,8 @@ type Node struct {
 
 type ParamExp struct {
 	Type string
+	ID int
+	ParentID int
 	Short bool
 	Excl bool
 	Length bool
@@ -294,6 +343,8 @@ type ParamExp struct {
 
 type ParenTest struct {
 	Type string
+	ID int
+	ParentID int
 	Op string
 	X interface{}
 	Lparen Pos
@@ -309,6 +360,8 @@ type Pos struct {
 }
 
 type Redirect struct {
+	ID int
+	ParentID int
 	Op string
 	N *Lit
 	Word *Word
@@ -316,10 +369,45 @@ type Redirect struct {
 	OpPos Pos
 	Pos Pos
 	End Pos
+	Fd RedirectFd
+	Heredoc *Heredoc
+}
+
+type RedirectFd struct {
+	// "input", "output", "append", "clobber", "input-output", "dup-input", "dup-output", "close", "heredoc" or "herestring"
+	Kind string
+	// redirected fd, from `N` or the default of `Op`, or -1 for `FdVar`
+	Fd int
+	// `{varname}>out` allocates an fd stored in `varname`
+	FdVar string
+	// also redirect stderr, for `&>`, `&>>` and `>&file`
+	Stderr bool
+	// dup target e.g. 1 for `2>&1`, or -1 if only known at runtime
+	TargetFd int
+	// close `TargetFd` after dup e.g. `2>&1-`
+	Move bool
+}
+
+type Heredoc struct {
+	// unquoted e.g. "EOF" for `<<'EOF'`
+	Delimiter string
+	// quoted delimiter means the body is not expanded
+	Quoted bool
+	// `<<-` strips leading tabs
+	StripTabs bool
+	// nil only if an empty body lacks a word, see `fillEmptyHeredocs`
+	BodyPos *Pos
+	BodyEnd *Pos
+	TerminatorPos *Pos
+	TerminatorEnd *Pos
+	// body without the terminator line, tabs stripped for `<<-`
+	Body string
 }
 
 type Replace struct {
 	Type string
+	ID int
+	ParentID int
 	All bool
 	Orig Word
 	With Word
@@ -329,6 +417,8 @@ type Replace struct {
 
 type SglQuoted struct {
 	Type string
+	ID int
+	ParentID int
 	Dollar bool
 	Value string
 	Left Pos
@@ -339,6 +429,8 @@ type SglQuoted struct {
 
 type Slice struct {
 	Type string
+	ID int
+	ParentID int
 	// Offset ArithmExpr
 	// Length ArithmExpr
 	Offset interface{}
@@ -348,6 +440,8 @@ type Slice struct {
 }
 
 type Stmt struct {
+	ID int
+	ParentID int
 	Comments []Comment
 	// interface type processor.Command not supported: only interface{} and easyjson/json Unmarshaler are allowed
 	Cmd        interface{} // Command
@@ -363,6 +457,8 @@ type Stmt struct {
 
 type SubShell struct {
 	Type string
+	ID int
+	ParentID int
 	Stmts []Stmt
 	Lparen Pos
 	Rparen Pos
@@ -372,6 +468,8 @@ type SubShell struct {
 
 type TestClause struct {
 	Type 	string
+	ID int
+	ParentID int
 	X 		interface{} // TestExpr
 	Pos 	Pos
 	End 	Pos
@@ -387,6 +485,8 @@ func (Word) testExprNode() {}
 
 type TimeClause struct {
 	Type string
+	ID int
+	ParentID int
 	PosixFormat bool
 	Stmt Stmt
 	Pos   Pos
@@ -395,6 +495,8 @@ type TimeClause struct {
 
 type UnaryTest struct {
 	Type 	string
+	ID int
+	ParentID int
 	Op 		string
 	// X  		TestExpr
 	X  		interface{}
@@ -404,12 +506,16 @@ type UnaryTest struct {
 
 type Unhandled struct {
 	Type string
+	ID int
+	ParentID int
 	Pos Pos
 	End Pos
 }
 
 type WhileClause struct {
 	Type string
+	ID int
+	ParentID int
 	Until bool
 	/** if non-nil an "elif" or an "else" */
 	Cond []Stmt;
@@ -423,6 +529,8 @@ type WhileClause struct {
 
 type Word struct {
 	Type string
+	ID int
+	ParentID int
 	Parts interface{} // []WordPart
 	// Lit   string
 	Pos   Pos
@@ -431,6 +539,8 @@ type Word struct {
 
 type WordIter struct {
 	Type 	string
+	ID int
+	ParentID int
 	Name  Lit
 	Items []Word
 	Pos   Pos
@@ -452,10 +562,606 @@ type ParseError struct {
 	Pos Pos
 }
 
+// A variable write performed during evaluation e.g. `x+=2`
+type VarAssign struct {
+	Name string
+	Unset bool
+	Kind string // "string" | "indexed" | "associative" | "nameref"
+	Value string
+	List []string
+	Map map[string]string
+	Local bool
+	Exported bool
+	ReadOnly bool
+}
+
+type EvalError struct {
+	// e.g. "DivisionByZero", "BadBase", "ValueTooGreatForBase", "Syntax"
+	Kind string
+	Message string
+	Pos Pos
+	End Pos
+}
+
+type EvalResult struct {
+	Value int64
+	Status int
+	Assigns []VarAssign
+	Error *EvalError
+}
+
+type ExpandResult struct {
+	Fields []string
+	Assigns []VarAssign
+	Error *EvalError
+}
+
+type QuoteError struct {
+	ByteOffset int
+	Message string
+}
+
+type QuotedValue struct {
+	Value string
+	Form string // "none" | "single" | "double" | "ansi"
+	Error *QuoteError
+}
+
+type QuoteResult struct {
+	Values []QuotedValue
+	// All values joined by spaces, or empty if any could not be quoted
+	Line string
+	// The input itself was invalid
+	Error *QuoteError
+}
+
+// A shell pattern as a JS regular expression e.g. `new RegExp(Regexp, Flags)`
+type PatternResult struct {
+	Regexp string
+	Flags string
+	Error *EvalError
+}
+
+type Rewrite struct {
+	// e.g. "let", "cstyle-for"
+	Pass string
+	Pos Pos
+	End Pos
+	Before string
+	After string
+}
+
+type DesugarResult struct {
+	File *File `json:"file"`
+	// desugared source, printed by `syntax.Printer`
+	Text string
+	Changes []Rewrite
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+type TextEdit struct {
+	Pos Pos
+	End Pos
+	NewText string
+}
+
+type LintFix struct {
+	Message string
+	Edits []TextEdit
+}
+
+type Diagnostic struct {
+	Rule string
+	// "error", "warning", "info" or "style"
+	Severity string
+	Message string
+	Pos Pos
+	End Pos
+	Fix *LintFix
+}
+
+type LintRuleInfo struct {
+	ID string
+	Severity string
+	Summary string
+	Fixable bool
+}
+
+type LintRulesResult struct {
+	Rules []LintRuleInfo
+}
+
+type LintOptions struct {
+	// module name to function names e.g. from `session.modules`, else runtime rules are skipped
+	Modules map[string][]string
+	// rule IDs
+	Disable []string
+	// rule ID to severity
+	Severities map[string]string
+}
+
+type LintResult struct {
+	Diagnostics []Diagnostic
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+type SymbolScope struct {
+	// 0 is the file
+	ID int
+	Parent int
+	// index into `SymbolTable.Functions`, else -1
+	Function int
+	Pos Pos
+	End Pos
+}
+
+type SymbolRef struct {
+	// e.g. "assign", "local", "read", "for", "arithm", "param", "indirect"
+	Kind string
+	Pos Pos
+	End Pos
+	// innermost enclosing scope
+	Scope int
+}
+
+type SymbolFunction struct {
+	Name string
+	// range of name
+	Pos Pos
+	End Pos
+	BodyPos Pos
+	BodyEnd Pos
+	// where declared
+	Scope int
+	BodyScope int
+	// e.g. ["1", "@", "#"]
+	Positionals []string
+}
+
+type SymbolVariable struct {
+	Name string
+	// 0 for globals, else the function scope of a `local`
+	Scope int
+	// "", "indexed", "associative" or "nameref"
+	Kind string
+	Local bool
+	Exported bool
+	ReadOnly bool
+	Integer bool
+	Defs []SymbolRef
+	Refs []SymbolRef
+}
+
+type SymbolCall struct {
+	Name string
+	Pos Pos
+	End Pos
+	Scope int
+	// index into `SymbolTable.Functions`
+	Function int
+}
+
+type SymbolTable struct {
+	Scopes []SymbolScope
+	Functions []SymbolFunction
+	Variables []SymbolVariable
+	// calls of functions declared in the file
+	Calls []SymbolCall
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+type RenameError struct {
+	// "NoSymbol", "InvalidName" or "Conflict"
+	Kind string
+	Message string
+}
+
+type RenameResult struct {
+	// "variable" or "function"
+	Kind string
+	OldName string
+	Edits []TextEdit
+	Error *RenameError
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+type CommandFlag struct {
+	// e.g. "-s" or "--force"
+	Name string
+	// whether it takes an argument e.g. `--to foo` or `--to=foo`
+	Arg bool
+	// see `CommandSignature.Args`
+	ArgKind string
+}
+
+type CommandSignature struct {
+	Name string
+	Flags []CommandFlag
+	// do not check flags
+	AnyFlags bool
+	MinArgs int
+	// nil means unbounded
+	MaxArgs *int
+	// kind of each operand e.g. "string", "pid", "number", "name", "path", "module-key"
+	Args []string
+	// kind of operands beyond `Args`
+	Rest string
+}
+
+type CommandRegistry struct {
+	Commands []CommandSignature
+	// module name to function names, for `import` and "module-key" arguments
+	Modules map[string][]string
+}
+
+type DepsFunction struct {
+	Name string
+	// defining file
+	File string
+	// set if induced by `import`
+	Module string
+	Pos Pos
+	End Pos
+	// number of static calls, so 0 suggests dead code unless called dynamically
+	Callers int
+}
+
+type DepsRef struct {
+	// "source", "import", "run", "map", "call" or "eval"
+	Kind string
+	// referring file
+	From string
+	// enclosing function, if any
+	Function string
+	// e.g. sourced path, module, "module.fn" or function name
+	Target string
+	// file which defines the target, if known
+	DefinedIn string
+	Module string
+	// could not be resolved statically e.g. `source $f`, `$cmd`, or `source rel.sh` lacking a cwd
+	Dynamic bool
+	Pos Pos
+	End Pos
+}
+
+type DepsGraph struct {
+	Functions []DepsFunction
+	Refs []DepsRef
+	// sourced files precede their sourcers
+	LoadOrder []string
+	// per file, in input order
+	ParseErrors []*ParseError
+	Message string
+}
+
+type DepsInputFile struct {
+	Path string
+	Text string
+}
+
+type DepsInput struct {
+	Files []DepsInputFile
+	// module name to function names, for `import` and `map`
+	Modules map[string][]string
+	Variant int
+	// resolves relative `source` targets, else they are dynamic
+	Cwd string
+}
+
+type DocArg struct {
+	Name string
+	Description string
+}
+
+type DocTag struct {
+	// e.g. "deprecated" for `@deprecated use foo`
+	Tag string
+	Text string
+}
+
+type DocEntry struct {
+	// "function", "variable" or "case-item"
+	Kind string
+	// function or variable name, else case patterns e.g. "start|stop"
+	Name string
+	// enclosing function, if any
+	Function string
+	Description string
+	// `@arg name description`
+	Args []DocArg
+	// `@example`, possibly spanning lines
+	Examples []string
+	// `@returns description`
+	Returns string
+	// other tags
+	Tags []DocTag
+	Pos Pos
+	End Pos
+	CommentPos *Pos
+	CommentEnd *Pos
+}
+
+type DocsResult struct {
+	Docs []DocEntry
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+// `AliasExpansion` is text produced by expanding an alias, so errors can be reported against the original.
+type AliasExpansion struct {
+	Name string
+	Value string
+	// index of the expansion containing this one, else -1
+	Parent int
+	// range in the expanded text
+	Pos Pos
+	End Pos
+	// range of the alias name in the original text, or of the outermost expansion if nested
+	OrigPos Pos
+	OrigEnd Pos
+}
+
+// `RunOptions` configures a `Runner`.
+type RunOptions struct {
+	// absolute working directory
+	Dir string
+	// positional parameters
+	Params []string
+	// commands run by the host even though bash implements them e.g. `test`, which evaluates JavaScript
+	Builtins []string
+}
+
+type RunResult struct {
+	Handle int
+	// index of the next top-level statement
+	Next int
+	Done bool
+	// exit status of the last statement run
+	Status int
+	// whether `exit` was run
+	Exited bool
+	Stdout string
+	Stderr string
+	Assigns []VarAssign
+	// fatal error e.g. a pipeline, which wasm cannot run, or a variable the host rejects
+	Error string
+	// range of the next statement
+	Pos *Pos
+	End *Pos
+	*ParseError `json:"parseError"`
+	Message string
+}
+
+// `Policy` restricts what an untrusted script may do, where zero values allow everything.
+type Policy struct {
+	// if non-empty, only these commands and the script's functions may run
+	AllowCommands []string
+	// also checked through wrappers e.g. `env rm` or `nohup rm`, though e.g. `find -exec rm`
+	// or a script run by name is missed, so prefer `AllowCommands` for untrusted scripts
+	DenyCommands []string
+	// e.g. `sleep 1 &`
+	DenyBackground bool
+	DenyCoproc bool
+	// `path.Match` patterns, where a directory also matches its descendants e.g. `/etc`
+	DenyRedirectPaths []string
+	// `$( … )`, backticks and `<( … )`
+	DenyCmdSubst bool
+	// `eval`, `source`, `.` or `sh -c` of a word which is not static e.g. `eval "$x"`,
+	// or of code which does not parse. Static code is checked like the script.
+	DenyDynamicEval bool
+	// if positive, the deepest chain of nested calls of the script's functions,
+	// and recursion between them is reported
+	MaxCallDepth int
+}
+
+// `ParseLimits` bound the resources of a parse, where zero means unlimited.
+// Exceeding one yields a `LimitError` rather than exhausting memory or the stack.
+type ParseLimits struct {
+	MaxInputBytes int
+	// nodes of the syntax tree
+	MaxNodes int
+	// nesting of the syntax tree, also estimated from the text before parsing e.g. `$( $( … ) )`
+	MaxDepth int
+	// size of the JSON result
+	MaxOutputBytes int
+	// nodes mapped before giving up
+	MaxSteps int
+	// milliseconds spent mapping before giving up
+	MaxMillis int
+}
+
+// `LimitError` reports an exceeded `ParseLimits`, where `Kind` is one of
+// "InputBytes", "Nodes", "Depth", "OutputBytes", "Steps" or "Time".
+type LimitError struct {
+	Kind string
+	Limit int
+	Message string
+}
+
+// `QueryMatch` is a node matching a selector, where `Path` locates it in `File` e.g. `Stmts.0.Cmd.Args.1`.
+type QueryMatch struct {
+	Type string
+	Path string
+	Pos Pos
+	End Pos
+}
+
+type QueryResult struct {
+	Matches []QueryMatch
+	// invalid selector
+	Error string
+	ParseError *ParseError `json:"parseError"`
+	Message string
+}
+
+// `DiffChange` is a top-level definition which differs between two versions of a script,
+// where ranges are null on the side lacking it.
+type DiffChange struct {
+	Kind string // "function" | "assignment" | "statement"
+	// function or variable name, else ""
+	Name string
+	Change string // "added" | "removed" | "modified"
+	OldPos *Pos
+	OldEnd *Pos
+	NewPos *Pos
+	NewEnd *Pos
+}
+
+type DiffResult struct {
+	Changes []DiffChange
+	ParseError *ParseError `json:"parseError"`
+	// whether `ParseError` is in the old text, rather than the new
+	ParseErrorInOld bool
+	Message string
+}
+
+// `BatchOptions` are parser options as named in JS, where omitted fields fall back to the batch's defaults.
+type BatchOptions struct {
+	KeepComments *bool `json:"keepComments"`
+	Variant *int `json:"variant"`
+	StopAt *string `json:"stopAt"`
+	RecoverErrors *int `json:"recoverErrors"`
+	// added to the defaults' aliases
+	Aliases map[string]string `json:"aliases"`
+	Limits *ParseLimits `json:"limits"`
+	NodeTable *bool `json:"nodeTable"`
+}
+
+type BatchItem struct {
+	// used as the file path
+	Name string `json:"name"`
+	Text string `json:"text"`
+	Options BatchOptions `json:"options"`
+}
+
+type Batch struct {
+	Defaults BatchOptions `json:"defaults"`
+	Items []BatchItem `json:"items"`
+}
+
+// `BatchResult` has a `Result` per item, in order, each with its own errors.
+type BatchResult struct {
+	Results []Result `json:"results"`
+	// invalid batch
+	Message string `json:"message"`
+}
+
+// `StmtResult` is the next top-level statement of a `StmtStream`, else `Done`.
+type StmtResult struct {
+	Handle int
+	Stmt *Stmt
+	Pos *Pos
+	End *Pos
+	// expansions within the statement, where ranges are in the text with aliases expanded so far
+	Aliases []AliasExpansion
+	Done bool
+	ParseError *ParseError `json:"parseError"`
+	LimitError *LimitError `json:"limitError"`
+	Message string
+}
+
+// `PortabilityIssue` is a construct unsupported by the target variant e.g. `[[ ]]` given posix.
+type PortabilityIssue struct {
+	// e.g. "[[ ]]", "array" or "process substitution"
+	Construct string
+	// node type e.g. "TestClause", or "" for a syntax error
+	Type string
+	Message string
+	Pos Pos
+	End Pos
+	// variants supporting the construct e.g. ["bash", "mksh", "bats"]
+	Variants []string
+}
+
+type PortabilityResult struct {
+	Issues []PortabilityIssue
+	// variants under which the whole text parses
+	Variants []string
+	ParseError *ParseError `json:"parseError"`
+	Message string
+}
+
+// `Capabilities` are what a runtime can execute, see `CheckCapabilities`.
+type Capabilities struct {
+	// node types e.g. "CallExpr" or "ProcSubst"
+	Nodes []string
+	// e.g. "&&", or "BinaryCmd:|&" for a specific node type
+	Operators []string
+}
+
+// a node of a mapped file, see `NodeTable`
+type NodeInfo struct {
+	ID int
+	ParentID int
+	Type string
+	// nil if the node lacks a range e.g. `Expansion`
+	Pos *Pos
+	End *Pos
+}
+
+// an executable statement, see `LineTable`
+type LineEntry struct {
+	// of the statement, or the item for a case arm
+	ID int
+	// "command", "condition", "loop" or "case-arm"
+	Kind string
+	// command type e.g. "CallExpr", or "" for a statement of only redirections
+	Type string
+	// innermost enclosing function, else ""
+	Function string
+	Pos Pos
+	End Pos
+}
+
+type BranchArm struct {
+	// "then", "elif", "else", "left", "right" or the patterns of a case item
+	Label string
+	// of the case item, else -1
+	ID int
+	// IDs of the arm's statements
+	Stmts []int
+	// taken when no other arm is, e.g. a missing `else`
+	Implicit bool
+	// nil if the arm lacks statements
+	Pos *Pos
+	End *Pos
+}
+
+// a choice between arms, see `LineTable`
+type Branch struct {
+	ID int
+	// "if", "case", "&&" or "||"
+	Kind string
+	Function string
+	Pos Pos
+	End Pos
+	Arms []BranchArm
+}
+
+type LineTableResult struct {
+	Entries []LineEntry
+	Branches []Branch
+	ParseError *ParseError `json:"parseError"`
+	Message string
+}
+
 type Result struct {
 	File `json:"file"`
+	// the expanded text if aliases were expanded
 	Text string `json:"text"`
+	Aliases []AliasExpansion `json:"aliases"`
 	*ParseError `json:"parseError"`
+	LimitError *LimitError `json:"limitError"`
+	// the variant parsed with, see `ResolveVariant`
+	Variant int `json:"variant"`
+	// "option", "directive", "shebang", "extension" or "default"
+	VariantSource string `json:"variantSource"`
+	// indexed by node `ID`, if `ParserOptions.NodeTable`
+	Nodes []NodeInfo `json:"nodes"`
 	Message string `json:"message"`
 }
 
@@ -479,6 +1185,7 @@ func MapParseError(err error) (*ParseError, string) {
 
 // 🚧
 func mapArithmExpr(node syntax.ArithmExpr) ArithmExpr {
+	spend()
 	if node == nil {
 		return nil
 	}
@@ -572,6 +1279,7 @@ func mapCaseItems(caseItems []*syntax.CaseItem) []CaseItem {
 }
 
 func mapCommand(node syntax.Command) Command {
+	spend()
 	if node == nil {
 		return nil
 	}
@@ -802,23 +1510,36 @@ func mapLoop(node syntax.Loop) Loop {
 	}
 }
 
-func mapPos(pos syntax.Pos) Pos {
+// `posShift` is added to mapped positions, if `StmtStream` parsed the rest of a text.
+var posShift textShift
+
+// `textShift` relates positions of a suffix of a text to the whole text, where
+// columns only shift on the suffix's first line.
+type textShift struct {
+	offset, line, col int
+}
+
+func (sh textShift) pos(offset, line, col uint) Pos {
+	if line == 1 {
+		col = uint(int(col) + sh.col)
+	}
 	return Pos{
-		Offset: pos.Offset(),
-		Line:   pos.Line(),
-		Col:    pos.Col(),
+		Offset: uint(int(offset) + sh.offset),
+		Line:   uint(int(line) + sh.line),
+		Col:    col,
 	}
 }
 
+func mapPos(pos syntax.Pos) Pos {
+	return posShift.pos(pos.Offset(), pos.Line(), pos.Col())
+}
+
 func mapNullablePos(pos syntax.Pos) *Pos {
 	if pos.IsValid() == false {
 		return nil
 	}
-	return &Pos{
-		Offset: pos.Offset(),
-		Line:   pos.Line(),
-		Col:    pos.Col(),
-	}
+	mapped := mapPos(pos)
+	return &mapped
 }
 
 // `mapRedirects` converts a slice of syntax.Redirect pointers into a slice of custom Redirect structures.
@@ -837,6 +1558,8 @@ func mapRedirects(redirects []*syntax.Redirect) []Redirect {
 			Hdoc:  mapWord(curr.Hdoc),
 			Pos:   mapPos(curr.Pos()),
 			End:   mapPos(curr.End()),
+			Fd:    mapRedirectFd(curr),
+			Heredoc: mapHeredoc(curr),
 		}
 	}
 	return redirs
@@ -866,6 +1589,7 @@ func mapSlice(slice *syntax.Slice) *Slice {
 }
 
 func mapStmt(stmt *syntax.Stmt) Stmt {
+	spend()
 	return Stmt{
 		Comments: mapComments(stmt.Comments),
 		Cmd: mapCommand(stmt.Cmd),
@@ -885,12 +1609,16 @@ func mapStmts(stmts []*syntax.Stmt) []Stmt {
 	stmtsSize := len(stmts)
 	stmtList := make([]Stmt, stmtsSize)
 	for i := range stmtsSize {
+		if budgetExceeded() {
+			break
+		}
 		stmtList[i] = mapStmt(stmts[i])
 	}
 	return stmtList
 }
 
 func mapTestExpr(node syntax.TestExpr) TestExpr {
+	spend()
 	if node == nil {
 		return nil
 	}
@@ -927,6 +1655,7 @@ func mapTestExpr(node syntax.TestExpr) TestExpr {
 // `mapWord` converts a *syntax.Word into a custom *Word structure. It maps each part of the syntax.Word using mapNode,
 // extracts the literal via Lit(), and maps the start and end positions using mapPos. If the input word is nil, it returns nil.
 func mapWord(word *syntax.Word) *Word {
+	spend()
 	if word == nil {
 		return nil
 	}
@@ -934,6 +1663,9 @@ func mapWord(word *syntax.Word) *Word {
 	size := len(word.Parts)
 	parts := make([]WordPart, size)
 	for i := range size {
+		if budgetExceeded() {
+			break
+		}
 		parts[i] = mapWordPart(word.Parts[i])
 	}
 
@@ -949,12 +1681,16 @@ func mapWords(words []*syntax.Word) []Word {
 	wordsSize := len(words)
 	wordList := make([]Word, wordsSize)
 	for i := range wordsSize {
+		if budgetExceeded() {
+			break
+		}
 		wordList[i] = *mapWord(words[i])
 	}
 	return wordList
 }
 
 func mapWordPart(part syntax.WordPart) WordPart {
+	spend()
 	if part == nil {
 		return nil
 	}
@@ -1023,13 +1759,16 @@ func mapWordParts(wordParts []syntax.WordPart) []WordPart {
 	outputSize := len(wordParts)
 	outputList := make([]WordPart, outputSize)
 	for i := range outputSize {
+		if budgetExceeded() {
+			break
+		}
 		outputList[i] = mapWordPart(wordParts[i])
 	}
 	return outputList
 }
 
 func MapFile(file syntax.File) File {
-	return File{
+	result := File{
 		Type: "File",
 		Name: file.Name,
 		Stmts: mapStmts(file.Stmts),
@@ -1037,4 +1776,6 @@ func MapFile(file syntax.File) File {
 		Pos:  mapPos(file.Pos()),
 		End:  mapPos(file.End()),
 	}
+	numberNodes(&result)
+	return result
 }
@@ -17,7 +17,61 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(in *jlexer.Lexer, out *WordIter) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(in *jlexer.Lexer, out *textShift) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(out *jwriter.Writer, in textShift) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v textShift) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v textShift) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *textShift) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *textShift) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(in *jlexer.Lexer, out *WordIter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -37,6 +91,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(in *j
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Name":
 			if in.IsNull() {
 				in.Skip()
@@ -92,7 +158,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(in *j
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(out *jwriter.Writer, in WordIter) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(out *jwriter.Writer, in WordIter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -101,6 +167,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(out *
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Name\":"
 		out.RawString(prefix)
@@ -138,27 +214,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(out *
 // MarshalJSON supports json.Marshaler interface
 func (v WordIter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v WordIter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *WordIter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *WordIter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(in *jlexer.Lexer, out *Word) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(in *jlexer.Lexer, out *Word) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -178,6 +254,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(in *
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Parts":
 			if m, ok := out.Parts.(easyjson.Unmarshaler); ok {
 				m.UnmarshalEasyJSON(in)
@@ -208,7 +296,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(out *jwriter.Writer, in Word) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(out *jwriter.Writer, in Word) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -217,6 +305,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Parts\":"
 		out.RawString(prefix)
@@ -244,27 +342,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(out
 // MarshalJSON supports json.Marshaler interface
 func (v Word) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Word) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Word) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Word) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor1(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(in *jlexer.Lexer, out *WhileClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(in *jlexer.Lexer, out *WhileClause) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -284,6 +382,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(in *
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Until":
 			if in.IsNull() {
 				in.Skip()
@@ -384,7 +494,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(out *jwriter.Writer, in WhileClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(out *jwriter.Writer, in WhileClause) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -393,6 +503,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Until\":"
 		out.RawString(prefix)
@@ -461,27 +581,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(out
 // MarshalJSON supports json.Marshaler interface
 func (v WhileClause) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v WhileClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *WhileClause) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *WhileClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor2(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(in *jlexer.Lexer, out *Unhandled) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(in *jlexer.Lexer, out *VarAssign) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -495,23 +615,94 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(in *
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Name = string(in.String())
 			}
-		case "Pos":
+		case "Unset":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				out.Unset = bool(in.Bool())
 			}
-		case "End":
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.Kind = string(in.String())
+			}
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = string(in.String())
+			}
+		case "List":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]string, 0, 4)
+					} else {
+						out.List = []string{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v10 = string(in.String())
+					}
+					out.List = append(out.List, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Map":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Map = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v11 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v11 = string(in.String())
+					}
+					(out.Map)[key] = v11
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Local":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Local = bool(in.Bool())
+			}
+		case "Exported":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Exported = bool(in.Bool())
+			}
+		case "ReadOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReadOnly = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -523,52 +714,109 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(out *jwriter.Writer, in Unhandled) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(out *jwriter.Writer, in VarAssign) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Unset\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		out.Bool(bool(in.Unset))
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"List\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.List {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v13))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Map\":"
+		out.RawString(prefix)
+		if in.Map == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v14First := true
+			for v14Name, v14Value := range in.Map {
+				if v14First {
+					v14First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v14Name))
+				out.RawByte(':')
+				out.String(string(v14Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Local\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Local))
+	}
+	{
+		const prefix string = ",\"Exported\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Exported))
+	}
+	{
+		const prefix string = ",\"ReadOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadOnly))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Unhandled) MarshalJSON() ([]byte, error) {
+func (v VarAssign) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Unhandled) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(w, v)
+func (v VarAssign) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Unhandled) UnmarshalJSON(data []byte) error {
+func (v *VarAssign) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Unhandled) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor3(l, v)
+func (v *VarAssign) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(in *jlexer.Lexer, out *UnaryTest) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(in *jlexer.Lexer, out *Unhandled) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -588,19 +836,17 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(in *
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Op":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Op = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "X":
-			if m, ok := out.X.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.X.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
 			} else {
-				out.X = in.Interface()
+				out.ParentID = int(in.Int())
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -624,7 +870,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(out *jwriter.Writer, in UnaryTest) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(out *jwriter.Writer, in Unhandled) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -634,20 +880,14 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Op\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.String(string(in.Op))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"X\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if m, ok := in.X.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.X.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.X))
-		}
+		out.Int(int(in.ParentID))
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -663,29 +903,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UnaryTest) MarshalJSON() ([]byte, error) {
+func (v Unhandled) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UnaryTest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(w, v)
+func (v Unhandled) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UnaryTest) UnmarshalJSON(data []byte) error {
+func (v *Unhandled) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UnaryTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor4(l, v)
+func (v *Unhandled) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(in *jlexer.Lexer, out *TimeClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(in *jlexer.Lexer, out *UnaryTest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -705,17 +945,31 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(in *
 			} else {
 				out.Type = string(in.String())
 			}
-		case "PosixFormat":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PosixFormat = bool(in.Bool())
+				out.ID = int(in.Int())
 			}
-		case "Stmt":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Stmt).UnmarshalEasyJSON(in)
+				out.ParentID = int(in.Int())
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "X":
+			if m, ok := out.X.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.X.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.X = in.Interface()
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -739,7 +993,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(out *jwriter.Writer, in TimeClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(out *jwriter.Writer, in UnaryTest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -749,14 +1003,30 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"PosixFormat\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.PosixFormat))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Stmt\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		(in.Stmt).MarshalEasyJSON(out)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		if m, ok := in.X.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.X.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.X))
+		}
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -772,29 +1042,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TimeClause) MarshalJSON() ([]byte, error) {
+func (v UnaryTest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TimeClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(w, v)
+func (v UnaryTest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TimeClause) UnmarshalJSON(data []byte) error {
+func (v *UnaryTest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TimeClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor5(l, v)
+func (v *UnaryTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(in *jlexer.Lexer, out *TestClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(in *jlexer.Lexer, out *TimeClause) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -814,13 +1084,29 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(in *
 			} else {
 				out.Type = string(in.String())
 			}
-		case "X":
-			if m, ok := out.X.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.X.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
 			} else {
-				out.X = in.Interface()
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "PosixFormat":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PosixFormat = bool(in.Bool())
+			}
+		case "Stmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Stmt).UnmarshalEasyJSON(in)
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -844,7 +1130,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(out *jwriter.Writer, in TestClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(out *jwriter.Writer, in TimeClause) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -854,15 +1140,24 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"X\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		if m, ok := in.X.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.X.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.X))
-		}
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"PosixFormat\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PosixFormat))
+	}
+	{
+		const prefix string = ",\"Stmt\":"
+		out.RawString(prefix)
+		(in.Stmt).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -878,29 +1173,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TestClause) MarshalJSON() ([]byte, error) {
+func (v TimeClause) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TestClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(w, v)
+func (v TimeClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TestClause) UnmarshalJSON(data []byte) error {
+func (v *TimeClause) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TestClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor6(l, v)
+func (v *TimeClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(in *jlexer.Lexer, out *SubShell) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(in *jlexer.Lexer, out *TextEdit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -914,51 +1209,6 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(in *
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Stmts":
-			if in.IsNull() {
-				in.Skip()
-				out.Stmts = nil
-			} else {
-				in.Delim('[')
-				if out.Stmts == nil {
-					if !in.IsDelim(']') {
-						out.Stmts = make([]Stmt, 0, 0)
-					} else {
-						out.Stmts = []Stmt{}
-					}
-				} else {
-					out.Stmts = (out.Stmts)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 Stmt
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v10).UnmarshalEasyJSON(in)
-					}
-					out.Stmts = append(out.Stmts, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "Lparen":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Lparen).UnmarshalEasyJSON(in)
-			}
-		case "Rparen":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Rparen).UnmarshalEasyJSON(in)
-			}
 		case "Pos":
 			if in.IsNull() {
 				in.Skip()
@@ -971,6 +1221,12 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(in *
 			} else {
 				(out.End).UnmarshalEasyJSON(in)
 			}
+		case "NewText":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NewText = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -981,44 +1237,13 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(out *jwriter.Writer, in SubShell) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(out *jwriter.Writer, in TextEdit) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"Type\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Stmts\":"
-		out.RawString(prefix)
-		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Stmts {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"Lparen\":"
-		out.RawString(prefix)
-		(in.Lparen).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Rparen\":"
-		out.RawString(prefix)
-		(in.Rparen).MarshalEasyJSON(out)
-	}
 	{
 		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
@@ -1026,33 +1251,38 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(out
 		out.RawString(prefix)
 		(in.End).MarshalEasyJSON(out)
 	}
+	{
+		const prefix string = ",\"NewText\":"
+		out.RawString(prefix)
+		out.String(string(in.NewText))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SubShell) MarshalJSON() ([]byte, error) {
+func (v TextEdit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SubShell) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(w, v)
+func (v TextEdit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SubShell) UnmarshalJSON(data []byte) error {
+func (v *TextEdit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SubShell) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor7(l, v)
+func (v *TextEdit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(in *jlexer.Lexer, out *Stmt) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(in *jlexer.Lexer, out *TestClause) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1066,117 +1296,229 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(in *
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Comments":
+		case "Type":
 			if in.IsNull() {
 				in.Skip()
-				out.Comments = nil
 			} else {
-				in.Delim('[')
-				if out.Comments == nil {
-					if !in.IsDelim(']') {
-						out.Comments = make([]Comment, 0, 0)
-					} else {
-						out.Comments = []Comment{}
-					}
-				} else {
-					out.Comments = (out.Comments)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 Comment
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v13).UnmarshalEasyJSON(in)
-					}
-					out.Comments = append(out.Comments, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Type = string(in.String())
 			}
-		case "Cmd":
-			if m, ok := out.Cmd.(easyjson.Unmarshaler); ok {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "X":
+			if m, ok := out.X.(easyjson.Unmarshaler); ok {
 				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Cmd.(json.Unmarshaler); ok {
+			} else if m, ok := out.X.(json.Unmarshaler); ok {
 				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				out.Cmd = in.Interface()
+				out.X = in.Interface()
 			}
-		case "Position":
+		case "Pos":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Position).UnmarshalEasyJSON(in)
+				(out.Pos).UnmarshalEasyJSON(in)
 			}
-		case "Semicolon":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
-				out.Semicolon = nil
 			} else {
-				if out.Semicolon == nil {
-					out.Semicolon = new(Pos)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Semicolon).UnmarshalEasyJSON(in)
-				}
+				(out.End).UnmarshalEasyJSON(in)
 			}
-		case "Negated":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(out *jwriter.Writer, in TestClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		if m, ok := in.X.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.X.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.X))
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TestClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TestClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TestClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TestClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(in *jlexer.Lexer, out *SymbolVariable) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Negated = bool(in.Bool())
+				out.Name = string(in.String())
 			}
-		case "Background":
+		case "Scope":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Background = bool(in.Bool())
+				out.Scope = int(in.Int())
 			}
-		case "Coprocess":
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Coprocess = bool(in.Bool())
+				out.Kind = string(in.String())
 			}
-		case "Redirs":
+		case "Local":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Local = bool(in.Bool())
+			}
+		case "Exported":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Exported = bool(in.Bool())
+			}
+		case "ReadOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReadOnly = bool(in.Bool())
+			}
+		case "Integer":
 			if in.IsNull() {
 				in.Skip()
-				out.Redirs = nil
+			} else {
+				out.Integer = bool(in.Bool())
+			}
+		case "Defs":
+			if in.IsNull() {
+				in.Skip()
+				out.Defs = nil
 			} else {
 				in.Delim('[')
-				if out.Redirs == nil {
+				if out.Defs == nil {
 					if !in.IsDelim(']') {
-						out.Redirs = make([]Redirect, 0, 0)
+						out.Defs = make([]SymbolRef, 0, 0)
 					} else {
-						out.Redirs = []Redirect{}
+						out.Defs = []SymbolRef{}
 					}
 				} else {
-					out.Redirs = (out.Redirs)[:0]
+					out.Defs = (out.Defs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v14 Redirect
+					var v15 SymbolRef
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v14).UnmarshalEasyJSON(in)
+						(v15).UnmarshalEasyJSON(in)
 					}
-					out.Redirs = append(out.Redirs, v14)
+					out.Defs = append(out.Defs, v15)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
+		case "Refs":
 			if in.IsNull() {
 				in.Skip()
+				out.Refs = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Refs == nil {
+					if !in.IsDelim(']') {
+						out.Refs = make([]SymbolRef, 0, 0)
+					} else {
+						out.Refs = []SymbolRef{}
+					}
+				} else {
+					out.Refs = (out.Refs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 SymbolRef
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v16).UnmarshalEasyJSON(in)
+					}
+					out.Refs = append(out.Refs, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1188,74 +1530,53 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(out *jwriter.Writer, in Stmt) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(out *jwriter.Writer, in SymbolVariable) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Comments\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v15, v16 := range in.Comments {
-				if v15 > 0 {
-					out.RawByte(',')
-				}
-				(v16).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Cmd\":"
+		const prefix string = ",\"Scope\":"
 		out.RawString(prefix)
-		if m, ok := in.Cmd.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Cmd.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Cmd))
-		}
+		out.Int(int(in.Scope))
 	}
 	{
-		const prefix string = ",\"Position\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"Semicolon\":"
+		const prefix string = ",\"Local\":"
 		out.RawString(prefix)
-		if in.Semicolon == nil {
-			out.RawString("null")
-		} else {
-			(*in.Semicolon).MarshalEasyJSON(out)
-		}
+		out.Bool(bool(in.Local))
 	}
 	{
-		const prefix string = ",\"Negated\":"
+		const prefix string = ",\"Exported\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Negated))
+		out.Bool(bool(in.Exported))
 	}
 	{
-		const prefix string = ",\"Background\":"
+		const prefix string = ",\"ReadOnly\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Background))
+		out.Bool(bool(in.ReadOnly))
 	}
 	{
-		const prefix string = ",\"Coprocess\":"
+		const prefix string = ",\"Integer\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Coprocess))
+		out.Bool(bool(in.Integer))
 	}
 	{
-		const prefix string = ",\"Redirs\":"
+		const prefix string = ",\"Defs\":"
 		out.RawString(prefix)
-		if in.Redirs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Defs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Redirs {
+			for v17, v18 := range in.Defs {
 				if v17 > 0 {
 					out.RawByte(',')
 				}
@@ -1265,42 +1586,48 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(out
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Refs\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.Refs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Refs {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				(v20).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Stmt) MarshalJSON() ([]byte, error) {
+func (v SymbolVariable) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Stmt) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(w, v)
+func (v SymbolVariable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Stmt) UnmarshalJSON(data []byte) error {
+func (v *SymbolVariable) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Stmt) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor8(l, v)
+func (v *SymbolVariable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(in *jlexer.Lexer, out *Slice) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(in *jlexer.Lexer, out *SymbolTable) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1309,44 +1636,139 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(in *
 		in.Skip()
 		return
 	}
+	out.ParseError = new(ParseError)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "Scopes":
 			if in.IsNull() {
 				in.Skip()
+				out.Scopes = nil
 			} else {
-				out.Type = string(in.String())
-			}
-		case "Offset":
-			if m, ok := out.Offset.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Offset.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Offset = in.Interface()
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]SymbolScope, 0, 0)
+					} else {
+						out.Scopes = []SymbolScope{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 SymbolScope
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v21).UnmarshalEasyJSON(in)
+					}
+					out.Scopes = append(out.Scopes, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "Length":
-			if m, ok := out.Length.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Length.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "Functions":
+			if in.IsNull() {
+				in.Skip()
+				out.Functions = nil
 			} else {
-				out.Length = in.Interface()
+				in.Delim('[')
+				if out.Functions == nil {
+					if !in.IsDelim(']') {
+						out.Functions = make([]SymbolFunction, 0, 0)
+					} else {
+						out.Functions = []SymbolFunction{}
+					}
+				} else {
+					out.Functions = (out.Functions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 SymbolFunction
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v22).UnmarshalEasyJSON(in)
+					}
+					out.Functions = append(out.Functions, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "Pos":
+		case "Variables":
 			if in.IsNull() {
 				in.Skip()
+				out.Variables = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Variables == nil {
+					if !in.IsDelim(']') {
+						out.Variables = make([]SymbolVariable, 0, 0)
+					} else {
+						out.Variables = []SymbolVariable{}
+					}
+				} else {
+					out.Variables = (out.Variables)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 SymbolVariable
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v23).UnmarshalEasyJSON(in)
+					}
+					out.Variables = append(out.Variables, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "End":
+		case "Calls":
 			if in.IsNull() {
 				in.Skip()
+				out.Calls = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Calls == nil {
+					if !in.IsDelim(']') {
+						out.Calls = make([]SymbolCall, 0, 0)
+					} else {
+						out.Calls = []SymbolCall{}
+					}
+				} else {
+					out.Calls = (out.Calls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 SymbolCall
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v24).UnmarshalEasyJSON(in)
+					}
+					out.Calls = append(out.Calls, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1358,74 +1780,115 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(in *
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(out *jwriter.Writer, in Slice) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(out *jwriter.Writer, in SymbolTable) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Scopes\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		if in.Scopes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Scopes {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				(v26).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"Offset\":"
+		const prefix string = ",\"Functions\":"
 		out.RawString(prefix)
-		if m, ok := in.Offset.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Offset.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Functions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Offset))
+			out.RawByte('[')
+			for v27, v28 := range in.Functions {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Length\":"
+		const prefix string = ",\"Variables\":"
 		out.RawString(prefix)
-		if m, ok := in.Length.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Length.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Variables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Length))
+			out.RawByte('[')
+			for v29, v30 := range in.Variables {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Calls\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		if in.Calls == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.Calls {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				(v32).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"parseError\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Slice) MarshalJSON() ([]byte, error) {
+func (v SymbolTable) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Slice) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(w, v)
+func (v SymbolTable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Slice) UnmarshalJSON(data []byte) error {
+func (v *SymbolTable) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Slice) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor9(l, v)
+func (v *SymbolTable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(in *jlexer.Lexer, out *SglQuoted) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(in *jlexer.Lexer, out *SymbolScope) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1439,35 +1902,23 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Dollar":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Dollar = bool(in.Bool())
-			}
-		case "Value":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Value = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "Left":
+		case "Parent":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Left).UnmarshalEasyJSON(in)
+				out.Parent = int(in.Int())
 			}
-		case "Right":
+		case "Function":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Right).UnmarshalEasyJSON(in)
+				out.Function = int(in.Int())
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -1491,34 +1942,24 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(out *jwriter.Writer, in SglQuoted) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(out *jwriter.Writer, in SymbolScope) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Dollar\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Dollar))
-	}
-	{
-		const prefix string = ",\"Value\":"
-		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Left\":"
+		const prefix string = ",\"Parent\":"
 		out.RawString(prefix)
-		(in.Left).MarshalEasyJSON(out)
+		out.Int(int(in.Parent))
 	}
 	{
-		const prefix string = ",\"Right\":"
+		const prefix string = ",\"Function\":"
 		out.RawString(prefix)
-		(in.Right).MarshalEasyJSON(out)
+		out.Int(int(in.Function))
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -1534,29 +1975,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SglQuoted) MarshalJSON() ([]byte, error) {
+func (v SymbolScope) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SglQuoted) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(w, v)
+func (v SymbolScope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SglQuoted) UnmarshalJSON(data []byte) error {
+func (v *SymbolScope) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SglQuoted) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor10(l, v)
+func (v *SymbolScope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(in *jlexer.Lexer, out *Result) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(in *jlexer.Lexer, out *SymbolRef) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1565,43 +2006,34 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(in
 		in.Skip()
 		return
 	}
-	out.ParseError = new(ParseError)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "file":
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.File).UnmarshalEasyJSON(in)
+				out.Kind = string(in.String())
 			}
-		case "text":
+		case "Pos":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Text = string(in.String())
+				(out.Pos).UnmarshalEasyJSON(in)
 			}
-		case "parseError":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
-				out.ParseError = nil
 			} else {
-				if out.ParseError == nil {
-					out.ParseError = new(ParseError)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.ParseError).UnmarshalEasyJSON(in)
-				}
+				(out.End).UnmarshalEasyJSON(in)
 			}
-		case "message":
+		case "Scope":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Message = string(in.String())
+				out.Scope = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -1613,61 +2045,57 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(out *jwriter.Writer, in Result) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(out *jwriter.Writer, in SymbolRef) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"file\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix[1:])
-		(in.File).MarshalEasyJSON(out)
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"parseError\":"
+		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		if in.ParseError == nil {
-			out.RawString("null")
-		} else {
-			(*in.ParseError).MarshalEasyJSON(out)
-		}
+		(in.End).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"Scope\":"
 		out.RawString(prefix)
-		out.String(string(in.Message))
+		out.Int(int(in.Scope))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Result) MarshalJSON() ([]byte, error) {
+func (v SymbolRef) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Result) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(w, v)
+func (v SymbolRef) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Result) UnmarshalJSON(data []byte) error {
+func (v *SymbolRef) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Result) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor11(l, v)
+func (v *SymbolRef) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(in *jlexer.Lexer, out *Replace) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(in *jlexer.Lexer, out *SymbolFunction) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1681,41 +2109,74 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Name = string(in.String())
 			}
-		case "All":
+		case "Pos":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.All = bool(in.Bool())
+				(out.Pos).UnmarshalEasyJSON(in)
 			}
-		case "Orig":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Orig).UnmarshalEasyJSON(in)
+				(out.End).UnmarshalEasyJSON(in)
 			}
-		case "With":
+		case "BodyPos":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.With).UnmarshalEasyJSON(in)
+				(out.BodyPos).UnmarshalEasyJSON(in)
 			}
-		case "Pos":
+		case "BodyEnd":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				(out.BodyEnd).UnmarshalEasyJSON(in)
 			}
-		case "End":
+		case "Scope":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.Scope = int(in.Int())
+			}
+		case "BodyScope":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BodyScope = int(in.Int())
+			}
+		case "Positionals":
+			if in.IsNull() {
+				in.Skip()
+				out.Positionals = nil
+			} else {
+				in.Delim('[')
+				if out.Positionals == nil {
+					if !in.IsDelim(']') {
+						out.Positionals = make([]string, 0, 4)
+					} else {
+						out.Positionals = []string{}
+					}
+				} else {
+					out.Positionals = (out.Positionals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v33 = string(in.String())
+					}
+					out.Positionals = append(out.Positionals, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1727,67 +2188,88 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(out *jwriter.Writer, in Replace) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(out *jwriter.Writer, in SymbolFunction) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"All\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.All))
+		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Orig\":"
+		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		(in.Orig).MarshalEasyJSON(out)
+		(in.End).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"With\":"
+		const prefix string = ",\"BodyPos\":"
 		out.RawString(prefix)
-		(in.With).MarshalEasyJSON(out)
+		(in.BodyPos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"BodyEnd\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		(in.BodyEnd).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Scope\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		out.Int(int(in.Scope))
+	}
+	{
+		const prefix string = ",\"BodyScope\":"
+		out.RawString(prefix)
+		out.Int(int(in.BodyScope))
+	}
+	{
+		const prefix string = ",\"Positionals\":"
+		out.RawString(prefix)
+		if in.Positionals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v34, v35 := range in.Positionals {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v35))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Replace) MarshalJSON() ([]byte, error) {
+func (v SymbolFunction) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Replace) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(w, v)
+func (v SymbolFunction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Replace) UnmarshalJSON(data []byte) error {
+func (v *SymbolFunction) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Replace) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor12(l, v)
+func (v *SymbolFunction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(in *jlexer.Lexer, out *Redirect) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(in *jlexer.Lexer, out *SymbolCall) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1801,71 +2283,35 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Op":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Op = string(in.String())
-			}
-		case "N":
-			if in.IsNull() {
-				in.Skip()
-				out.N = nil
-			} else {
-				if out.N == nil {
-					out.N = new(Lit)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.N).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Word":
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
-				out.Word = nil
 			} else {
-				if out.Word == nil {
-					out.Word = new(Word)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Word).UnmarshalEasyJSON(in)
-				}
+				out.Name = string(in.String())
 			}
-		case "Hdoc":
+		case "Pos":
 			if in.IsNull() {
 				in.Skip()
-				out.Hdoc = nil
 			} else {
-				if out.Hdoc == nil {
-					out.Hdoc = new(Word)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Hdoc).UnmarshalEasyJSON(in)
-				}
+				(out.Pos).UnmarshalEasyJSON(in)
 			}
-		case "OpPos":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.OpPos).UnmarshalEasyJSON(in)
+				(out.End).UnmarshalEasyJSON(in)
 			}
-		case "Pos":
+		case "Scope":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				out.Scope = int(in.Int())
 			}
-		case "End":
+		case "Function":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.Function = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -1877,84 +2323,62 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(out *jwriter.Writer, in Redirect) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(out *jwriter.Writer, in SymbolCall) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Op\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Op))
-	}
-	{
-		const prefix string = ",\"N\":"
-		out.RawString(prefix)
-		if in.N == nil {
-			out.RawString("null")
-		} else {
-			(*in.N).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"Word\":"
-		out.RawString(prefix)
-		if in.Word == nil {
-			out.RawString("null")
-		} else {
-			(*in.Word).MarshalEasyJSON(out)
-		}
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Hdoc\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		if in.Hdoc == nil {
-			out.RawString("null")
-		} else {
-			(*in.Hdoc).MarshalEasyJSON(out)
-		}
+		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"OpPos\":"
+		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		(in.OpPos).MarshalEasyJSON(out)
+		(in.End).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Scope\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		out.Int(int(in.Scope))
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Function\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		out.Int(int(in.Function))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Redirect) MarshalJSON() ([]byte, error) {
+func (v SymbolCall) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Redirect) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(w, v)
+func (v SymbolCall) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Redirect) UnmarshalJSON(data []byte) error {
+func (v *SymbolCall) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Redirect) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor13(l, v)
+func (v *SymbolCall) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(in *jlexer.Lexer, out *Pos) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(in *jlexer.Lexer, out *SubShell) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1968,23 +2392,74 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Offset":
+		case "Type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Offset = uint(in.Uint())
+				out.Type = string(in.String())
 			}
-		case "Line":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Line = uint(in.Uint())
+				out.ID = int(in.Int())
 			}
-		case "Col":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Col = uint(in.Uint())
+				out.ParentID = int(in.Int())
+			}
+		case "Stmts":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmts = nil
+			} else {
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]Stmt, 0, 0)
+					} else {
+						out.Stmts = []Stmt{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v36 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v36).UnmarshalEasyJSON(in)
+					}
+					out.Stmts = append(out.Stmts, v36)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Lparen":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Lparen).UnmarshalEasyJSON(in)
+			}
+		case "Rparen":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Rparen).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -1996,52 +2471,88 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(out *jwriter.Writer, in Pos) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(out *jwriter.Writer, in SubShell) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Offset\":"
+		const prefix string = ",\"Type\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.Offset))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Line\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Line))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Col\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Col))
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Stmts\":"
+		out.RawString(prefix)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Stmts {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				(v38).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Lparen\":"
+		out.RawString(prefix)
+		(in.Lparen).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Rparen\":"
+		out.RawString(prefix)
+		(in.Rparen).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Pos) MarshalJSON() ([]byte, error) {
+func (v SubShell) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Pos) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(w, v)
+func (v SubShell) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Pos) UnmarshalJSON(data []byte) error {
+func (v *SubShell) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Pos) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor14(l, v)
+func (v *SubShell) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(in *jlexer.Lexer, out *ParseError) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(in *jlexer.Lexer, out *StmtResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2055,29 +2566,120 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
+		case "Handle":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Handle = int(in.Int())
+			}
+		case "Stmt":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmt = nil
+			} else {
+				if out.Stmt == nil {
+					out.Stmt = new(Stmt)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Stmt).UnmarshalEasyJSON(in)
+				}
+			}
 		case "Pos":
 			if in.IsNull() {
 				in.Skip()
+				out.Pos = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				if out.Pos == nil {
+					out.Pos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Pos).UnmarshalEasyJSON(in)
+				}
 			}
-		case "Filename":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
+				out.End = nil
 			} else {
-				out.Filename = string(in.String())
+				if out.End == nil {
+					out.End = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.End).UnmarshalEasyJSON(in)
+				}
 			}
-		case "Text":
+		case "Aliases":
 			if in.IsNull() {
 				in.Skip()
+				out.Aliases = nil
 			} else {
-				out.Text = string(in.String())
+				in.Delim('[')
+				if out.Aliases == nil {
+					if !in.IsDelim(']') {
+						out.Aliases = make([]AliasExpansion, 0, 0)
+					} else {
+						out.Aliases = []AliasExpansion{}
+					}
+				} else {
+					out.Aliases = (out.Aliases)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 AliasExpansion
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v39).UnmarshalEasyJSON(in)
+					}
+					out.Aliases = append(out.Aliases, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "Incomplete":
+		case "Done":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Incomplete = bool(in.Bool())
+				out.Done = bool(in.Bool())
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "limitError":
+			if in.IsNull() {
+				in.Skip()
+				out.LimitError = nil
+			} else {
+				if out.LimitError == nil {
+					out.LimitError = new(LimitError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.LimitError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2089,57 +2691,113 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(out *jwriter.Writer, in ParseError) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(out *jwriter.Writer, in StmtResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Handle\":"
 		out.RawString(prefix[1:])
-		(in.Pos).MarshalEasyJSON(out)
+		out.Int(int(in.Handle))
 	}
 	{
-		const prefix string = ",\"Filename\":"
+		const prefix string = ",\"Stmt\":"
 		out.RawString(prefix)
-		out.String(string(in.Filename))
+		if in.Stmt == nil {
+			out.RawString("null")
+		} else {
+			(*in.Stmt).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"Text\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		if in.Pos == nil {
+			out.RawString("null")
+		} else {
+			(*in.Pos).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"Incomplete\":"
+		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Incomplete))
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			(*in.End).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Aliases\":"
+		out.RawString(prefix)
+		if in.Aliases == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Aliases {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				(v41).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"limitError\":"
+		out.RawString(prefix)
+		if in.LimitError == nil {
+			out.RawString("null")
+		} else {
+			(*in.LimitError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ParseError) MarshalJSON() ([]byte, error) {
+func (v StmtResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ParseError) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(w, v)
+func (v StmtResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ParseError) UnmarshalJSON(data []byte) error {
+func (v *StmtResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ParseError) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor15(l, v)
+func (v *StmtResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(in *jlexer.Lexer, out *ParenTest) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(in *jlexer.Lexer, out *Stmt) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2153,37 +2811,117 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "Op":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Op = string(in.String())
+				out.ParentID = int(in.Int())
 			}
-		case "X":
-			if m, ok := out.X.(easyjson.Unmarshaler); ok {
+		case "Comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]Comment, 0, 0)
+					} else {
+						out.Comments = []Comment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v42 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v42).UnmarshalEasyJSON(in)
+					}
+					out.Comments = append(out.Comments, v42)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Cmd":
+			if m, ok := out.Cmd.(easyjson.Unmarshaler); ok {
 				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.X.(json.Unmarshaler); ok {
+			} else if m, ok := out.Cmd.(json.Unmarshaler); ok {
 				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				out.X = in.Interface()
+				out.Cmd = in.Interface()
 			}
-		case "Lparen":
+		case "Position":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Lparen).UnmarshalEasyJSON(in)
+				(out.Position).UnmarshalEasyJSON(in)
 			}
-		case "Rparen":
+		case "Semicolon":
 			if in.IsNull() {
 				in.Skip()
+				out.Semicolon = nil
 			} else {
-				(out.Rparen).UnmarshalEasyJSON(in)
+				if out.Semicolon == nil {
+					out.Semicolon = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Semicolon).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Negated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Negated = bool(in.Bool())
+			}
+		case "Background":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Background = bool(in.Bool())
+			}
+		case "Coprocess":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coprocess = bool(in.Bool())
+			}
+		case "Redirs":
+			if in.IsNull() {
+				in.Skip()
+				out.Redirs = nil
+			} else {
+				in.Delim('[')
+				if out.Redirs == nil {
+					if !in.IsDelim(']') {
+						out.Redirs = make([]Redirect, 0, 0)
+					} else {
+						out.Redirs = []Redirect{}
+					}
+				} else {
+					out.Redirs = (out.Redirs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 Redirect
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v43).UnmarshalEasyJSON(in)
+					}
+					out.Redirs = append(out.Redirs, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -2207,40 +2945,91 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(out *jwriter.Writer, in ParenTest) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(out *jwriter.Writer, in Stmt) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Op\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		out.String(string(in.Op))
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"X\":"
+		const prefix string = ",\"Comments\":"
 		out.RawString(prefix)
-		if m, ok := in.X.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.X.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.X))
+			out.RawByte('[')
+			for v44, v45 := range in.Comments {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Lparen\":"
+		const prefix string = ",\"Cmd\":"
 		out.RawString(prefix)
-		(in.Lparen).MarshalEasyJSON(out)
+		if m, ok := in.Cmd.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Cmd.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Cmd))
+		}
 	}
 	{
-		const prefix string = ",\"Rparen\":"
+		const prefix string = ",\"Position\":"
 		out.RawString(prefix)
-		(in.Rparen).MarshalEasyJSON(out)
+		(in.Position).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Semicolon\":"
+		out.RawString(prefix)
+		if in.Semicolon == nil {
+			out.RawString("null")
+		} else {
+			(*in.Semicolon).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Negated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Negated))
+	}
+	{
+		const prefix string = ",\"Background\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Background))
+	}
+	{
+		const prefix string = ",\"Coprocess\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Coprocess))
+	}
+	{
+		const prefix string = ",\"Redirs\":"
+		out.RawString(prefix)
+		if in.Redirs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.Redirs {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				(v47).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -2256,29 +3045,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ParenTest) MarshalJSON() ([]byte, error) {
+func (v Stmt) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ParenTest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(w, v)
+func (v Stmt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ParenTest) UnmarshalJSON(data []byte) error {
+func (v *Stmt) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ParenTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor16(l, v)
+func (v *Stmt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(in *jlexer.Lexer, out *ParamExp) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(in *jlexer.Lexer, out *Slice) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2298,103 +3087,33 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Short":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Short = bool(in.Bool())
-			}
-		case "Excl":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Excl = bool(in.Bool())
-			}
-		case "Length":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Length = bool(in.Bool())
-			}
-		case "Width":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Width = bool(in.Bool())
+				out.ID = int(in.Int())
 			}
-		case "Param":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Param).UnmarshalEasyJSON(in)
+				out.ParentID = int(in.Int())
 			}
-		case "Index":
-			if m, ok := out.Index.(easyjson.Unmarshaler); ok {
+		case "Offset":
+			if m, ok := out.Offset.(easyjson.Unmarshaler); ok {
 				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Index.(json.Unmarshaler); ok {
+			} else if m, ok := out.Offset.(json.Unmarshaler); ok {
 				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				out.Index = in.Interface()
-			}
-		case "Slice":
-			if in.IsNull() {
-				in.Skip()
-				out.Slice = nil
-			} else {
-				if out.Slice == nil {
-					out.Slice = new(Slice)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Slice).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Repl":
-			if in.IsNull() {
-				in.Skip()
-				out.Repl = nil
-			} else {
-				if out.Repl == nil {
-					out.Repl = new(Replace)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Repl).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Names":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Names = string(in.String())
-			}
-		case "Exp":
-			if in.IsNull() {
-				in.Skip()
-				out.Exp = nil
-			} else {
-				if out.Exp == nil {
-					out.Exp = new(Expansion)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Exp).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Dollar":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Dollar).UnmarshalEasyJSON(in)
+				out.Offset = in.Interface()
 			}
-		case "Rbrace":
-			if in.IsNull() {
-				in.Skip()
+		case "Length":
+			if m, ok := out.Length.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Length.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				(out.Rbrace).UnmarshalEasyJSON(in)
+				out.Length = in.Interface()
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -2418,7 +3137,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(out *jwriter.Writer, in ParamExp) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(out *jwriter.Writer, in Slice) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2428,83 +3147,37 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Short\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Short))
-	}
-	{
-		const prefix string = ",\"Excl\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Excl))
-	}
-	{
-		const prefix string = ",\"Length\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Length))
-	}
-	{
-		const prefix string = ",\"Width\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Width))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Param\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		(in.Param).MarshalEasyJSON(out)
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"Index\":"
+		const prefix string = ",\"Offset\":"
 		out.RawString(prefix)
-		if m, ok := in.Index.(easyjson.Marshaler); ok {
+		if m, ok := in.Offset.(easyjson.Marshaler); ok {
 			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Index.(json.Marshaler); ok {
+		} else if m, ok := in.Offset.(json.Marshaler); ok {
 			out.Raw(m.MarshalJSON())
 		} else {
-			out.Raw(json.Marshal(in.Index))
-		}
-	}
-	{
-		const prefix string = ",\"Slice\":"
-		out.RawString(prefix)
-		if in.Slice == nil {
-			out.RawString("null")
-		} else {
-			(*in.Slice).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"Repl\":"
-		out.RawString(prefix)
-		if in.Repl == nil {
-			out.RawString("null")
-		} else {
-			(*in.Repl).MarshalEasyJSON(out)
+			out.Raw(json.Marshal(in.Offset))
 		}
 	}
 	{
-		const prefix string = ",\"Names\":"
-		out.RawString(prefix)
-		out.String(string(in.Names))
-	}
-	{
-		const prefix string = ",\"Exp\":"
+		const prefix string = ",\"Length\":"
 		out.RawString(prefix)
-		if in.Exp == nil {
-			out.RawString("null")
+		if m, ok := in.Length.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Length.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
 		} else {
-			(*in.Exp).MarshalEasyJSON(out)
+			out.Raw(json.Marshal(in.Length))
 		}
 	}
-	{
-		const prefix string = ",\"Dollar\":"
-		out.RawString(prefix)
-		(in.Dollar).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Rbrace\":"
-		out.RawString(prefix)
-		(in.Rbrace).MarshalEasyJSON(out)
-	}
 	{
 		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
@@ -2519,29 +3192,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ParamExp) MarshalJSON() ([]byte, error) {
+func (v Slice) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ParamExp) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(w, v)
+func (v Slice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ParamExp) UnmarshalJSON(data []byte) error {
+func (v *Slice) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ParamExp) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor17(l, v)
+func (v *Slice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(in *jlexer.Lexer, out *Node) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(in *jlexer.Lexer, out *SglQuoted) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2555,99 +3228,47 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Pos":
+		case "Type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				out.Type = string(in.String())
 			}
-		case "End":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Dollar":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dollar = bool(in.Bool())
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(out *jwriter.Writer, in Node) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix[1:])
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
-		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Node) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Node) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Node) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Node) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor18(l, v)
-}
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(in *jlexer.Lexer, out *Lit) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		switch key {
 		case "Value":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.Value = string(in.String())
 			}
-		case "ValuePos":
+		case "Left":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.ValuePos).UnmarshalEasyJSON(in)
+				(out.Left).UnmarshalEasyJSON(in)
 			}
-		case "ValueEnd":
+		case "Right":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.ValueEnd).UnmarshalEasyJSON(in)
+				(out.Right).UnmarshalEasyJSON(in)
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -2671,24 +3292,44 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(out *jwriter.Writer, in Lit) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(out *jwriter.Writer, in SglQuoted) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"Type\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Dollar\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dollar))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
 		out.String(string(in.Value))
 	}
 	{
-		const prefix string = ",\"ValuePos\":"
+		const prefix string = ",\"Left\":"
 		out.RawString(prefix)
-		(in.ValuePos).MarshalEasyJSON(out)
+		(in.Left).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"ValueEnd\":"
+		const prefix string = ",\"Right\":"
 		out.RawString(prefix)
-		(in.ValueEnd).MarshalEasyJSON(out)
+		(in.Right).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -2704,29 +3345,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Lit) MarshalJSON() ([]byte, error) {
+func (v SglQuoted) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Lit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(w, v)
+func (v SglQuoted) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Lit) UnmarshalJSON(data []byte) error {
+func (v *SglQuoted) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Lit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor19(l, v)
+func (v *SglQuoted) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(in *jlexer.Lexer, out *LetClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(in *jlexer.Lexer, out *RunResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2735,42 +3376,134 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(in
 		in.Skip()
 		return
 	}
+	out.ParseError = new(ParseError)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "Handle":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Handle = int(in.Int())
 			}
-		case "Exprs":
-			if m, ok := out.Exprs.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Exprs.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "Next":
+			if in.IsNull() {
+				in.Skip()
 			} else {
-				out.Exprs = in.Interface()
+				out.Next = int(in.Int())
 			}
-		case "Let":
+		case "Done":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Let).UnmarshalEasyJSON(in)
+				out.Done = bool(in.Bool())
+			}
+		case "Status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = int(in.Int())
+			}
+		case "Exited":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Exited = bool(in.Bool())
+			}
+		case "Stdout":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Stdout = string(in.String())
+			}
+		case "Stderr":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Stderr = string(in.String())
+			}
+		case "Assigns":
+			if in.IsNull() {
+				in.Skip()
+				out.Assigns = nil
+			} else {
+				in.Delim('[')
+				if out.Assigns == nil {
+					if !in.IsDelim(']') {
+						out.Assigns = make([]VarAssign, 0, 0)
+					} else {
+						out.Assigns = []VarAssign{}
+					}
+				} else {
+					out.Assigns = (out.Assigns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 VarAssign
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v48).UnmarshalEasyJSON(in)
+					}
+					out.Assigns = append(out.Assigns, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Error = string(in.String())
 			}
 		case "Pos":
 			if in.IsNull() {
 				in.Skip()
+				out.Pos = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				if out.Pos == nil {
+					out.Pos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Pos).UnmarshalEasyJSON(in)
+				}
 			}
 		case "End":
 			if in.IsNull() {
 				in.Skip()
+				out.End = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				if out.End == nil {
+					out.End = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.End).UnmarshalEasyJSON(in)
+				}
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2782,68 +3515,125 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(out *jwriter.Writer, in LetClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(out *jwriter.Writer, in RunResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Handle\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.Handle))
 	}
 	{
-		const prefix string = ",\"Exprs\":"
+		const prefix string = ",\"Next\":"
 		out.RawString(prefix)
-		if m, ok := in.Exprs.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Exprs.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		out.Int(int(in.Next))
+	}
+	{
+		const prefix string = ",\"Done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"Exited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Exited))
+	}
+	{
+		const prefix string = ",\"Stdout\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdout))
+	}
+	{
+		const prefix string = ",\"Stderr\":"
+		out.RawString(prefix)
+		out.String(string(in.Stderr))
+	}
+	{
+		const prefix string = ",\"Assigns\":"
+		out.RawString(prefix)
+		if in.Assigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Exprs))
+			out.RawByte('[')
+			for v49, v50 := range in.Assigns {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				(v50).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Let\":"
+		const prefix string = ",\"Error\":"
 		out.RawString(prefix)
-		(in.Let).MarshalEasyJSON(out)
+		out.String(string(in.Error))
 	}
 	{
 		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		if in.Pos == nil {
+			out.RawString("null")
+		} else {
+			(*in.Pos).MarshalEasyJSON(out)
+		}
 	}
 	{
 		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			(*in.End).MarshalEasyJSON(out)
+		}
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v LetClause) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RunResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LetClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(w, v)
+func (v RunResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LetClause) UnmarshalJSON(data []byte) error {
+func (v *RunResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LetClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor20(l, v)
+func (v *RunResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(in *jlexer.Lexer, out *IfClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(in *jlexer.Lexer, out *RunOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2857,184 +3647,9045 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "Dir":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Dir = string(in.String())
 			}
-		case "Cond":
+		case "Params":
 			if in.IsNull() {
 				in.Skip()
-				out.Cond = nil
+				out.Params = nil
 			} else {
 				in.Delim('[')
-				if out.Cond == nil {
+				if out.Params == nil {
 					if !in.IsDelim(']') {
-						out.Cond = make([]Stmt, 0, 0)
+						out.Params = make([]string, 0, 4)
 					} else {
-						out.Cond = []Stmt{}
+						out.Params = []string{}
 					}
 				} else {
-					out.Cond = (out.Cond)[:0]
+					out.Params = (out.Params)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v51 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v51 = string(in.String())
+					}
+					out.Params = append(out.Params, v51)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Builtins":
+			if in.IsNull() {
+				in.Skip()
+				out.Builtins = nil
+			} else {
+				in.Delim('[')
+				if out.Builtins == nil {
+					if !in.IsDelim(']') {
+						out.Builtins = make([]string, 0, 4)
+					} else {
+						out.Builtins = []string{}
+					}
+				} else {
+					out.Builtins = (out.Builtins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v52 = string(in.String())
+					}
+					out.Builtins = append(out.Builtins, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(out *jwriter.Writer, in RunOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Dir\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Dir))
+	}
+	{
+		const prefix string = ",\"Params\":"
+		out.RawString(prefix)
+		if in.Params == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Params {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v54))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Builtins\":"
+		out.RawString(prefix)
+		if in.Builtins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Builtins {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v56))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RunOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RunOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RunOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RunOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(in *jlexer.Lexer, out *Rewrite) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Pass":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pass = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Before":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Before = string(in.String())
+			}
+		case "After":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.After = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(out *jwriter.Writer, in Rewrite) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pass\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Pass))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Before\":"
+		out.RawString(prefix)
+		out.String(string(in.Before))
+	}
+	{
+		const prefix string = ",\"After\":"
+		out.RawString(prefix)
+		out.String(string(in.After))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Rewrite) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Rewrite) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Rewrite) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Rewrite) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(in *jlexer.Lexer, out *Result) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "file":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.File).UnmarshalEasyJSON(in)
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "aliases":
+			if in.IsNull() {
+				in.Skip()
+				out.Aliases = nil
+			} else {
+				in.Delim('[')
+				if out.Aliases == nil {
+					if !in.IsDelim(']') {
+						out.Aliases = make([]AliasExpansion, 0, 0)
+					} else {
+						out.Aliases = []AliasExpansion{}
+					}
+				} else {
+					out.Aliases = (out.Aliases)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 Stmt
+					var v57 AliasExpansion
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v19).UnmarshalEasyJSON(in)
+						(v57).UnmarshalEasyJSON(in)
 					}
-					out.Cond = append(out.Cond, v19)
+					out.Aliases = append(out.Aliases, v57)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Then":
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "limitError":
+			if in.IsNull() {
+				in.Skip()
+				out.LimitError = nil
+			} else {
+				if out.LimitError == nil {
+					out.LimitError = new(LimitError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.LimitError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "variant":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Variant = int(in.Int())
+			}
+		case "variantSource":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.VariantSource = string(in.String())
+			}
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]NodeInfo, 0, 1)
+					} else {
+						out.Nodes = []NodeInfo{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 NodeInfo
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v58).UnmarshalEasyJSON(in)
+					}
+					out.Nodes = append(out.Nodes, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(out *jwriter.Writer, in Result) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"file\":"
+		out.RawString(prefix[1:])
+		(in.File).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"aliases\":"
+		out.RawString(prefix)
+		if in.Aliases == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Aliases {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				(v60).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"limitError\":"
+		out.RawString(prefix)
+		if in.LimitError == nil {
+			out.RawString("null")
+		} else {
+			(*in.LimitError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"variant\":"
+		out.RawString(prefix)
+		out.Int(int(in.Variant))
+	}
+	{
+		const prefix string = ",\"variantSource\":"
+		out.RawString(prefix)
+		out.String(string(in.VariantSource))
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v61, v62 := range in.Nodes {
+				if v61 > 0 {
+					out.RawByte(',')
+				}
+				(v62).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Result) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Result) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Result) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Result) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(in *jlexer.Lexer, out *Replace) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "All":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.All = bool(in.Bool())
+			}
+		case "Orig":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Orig).UnmarshalEasyJSON(in)
+			}
+		case "With":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.With).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(out *jwriter.Writer, in Replace) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"All\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.All))
+	}
+	{
+		const prefix string = ",\"Orig\":"
+		out.RawString(prefix)
+		(in.Orig).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"With\":"
+		out.RawString(prefix)
+		(in.With).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Replace) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Replace) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Replace) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Replace) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(in *jlexer.Lexer, out *RenameResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "OldName":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OldName = string(in.String())
+			}
+		case "Edits":
+			if in.IsNull() {
+				in.Skip()
+				out.Edits = nil
+			} else {
+				in.Delim('[')
+				if out.Edits == nil {
+					if !in.IsDelim(']') {
+						out.Edits = make([]TextEdit, 0, 1)
+					} else {
+						out.Edits = []TextEdit{}
+					}
+				} else {
+					out.Edits = (out.Edits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v63 TextEdit
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v63).UnmarshalEasyJSON(in)
+					}
+					out.Edits = append(out.Edits, v63)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(RenameError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(out *jwriter.Writer, in RenameResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"OldName\":"
+		out.RawString(prefix)
+		out.String(string(in.OldName))
+	}
+	{
+		const prefix string = ",\"Edits\":"
+		out.RawString(prefix)
+		if in.Edits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v64, v65 := range in.Edits {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				(v65).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RenameResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RenameResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RenameResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RenameResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(in *jlexer.Lexer, out *RenameError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(out *jwriter.Writer, in RenameError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RenameError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RenameError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RenameError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RenameError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(in *jlexer.Lexer, out *RedirectFd) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Fd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Fd = int(in.Int())
+			}
+		case "FdVar":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FdVar = string(in.String())
+			}
+		case "Stderr":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Stderr = bool(in.Bool())
+			}
+		case "TargetFd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TargetFd = int(in.Int())
+			}
+		case "Move":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Move = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(out *jwriter.Writer, in RedirectFd) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Fd\":"
+		out.RawString(prefix)
+		out.Int(int(in.Fd))
+	}
+	{
+		const prefix string = ",\"FdVar\":"
+		out.RawString(prefix)
+		out.String(string(in.FdVar))
+	}
+	{
+		const prefix string = ",\"Stderr\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Stderr))
+	}
+	{
+		const prefix string = ",\"TargetFd\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetFd))
+	}
+	{
+		const prefix string = ",\"Move\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Move))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RedirectFd) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RedirectFd) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RedirectFd) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RedirectFd) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(in *jlexer.Lexer, out *Redirect) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "N":
+			if in.IsNull() {
+				in.Skip()
+				out.N = nil
+			} else {
+				if out.N == nil {
+					out.N = new(Lit)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.N).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Word":
+			if in.IsNull() {
+				in.Skip()
+				out.Word = nil
+			} else {
+				if out.Word == nil {
+					out.Word = new(Word)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Word).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Hdoc":
+			if in.IsNull() {
+				in.Skip()
+				out.Hdoc = nil
+			} else {
+				if out.Hdoc == nil {
+					out.Hdoc = new(Word)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Hdoc).UnmarshalEasyJSON(in)
+				}
+			}
+		case "OpPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.OpPos).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Fd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Fd).UnmarshalEasyJSON(in)
+			}
+		case "Heredoc":
+			if in.IsNull() {
+				in.Skip()
+				out.Heredoc = nil
+			} else {
+				if out.Heredoc == nil {
+					out.Heredoc = new(Heredoc)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Heredoc).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(out *jwriter.Writer, in Redirect) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"N\":"
+		out.RawString(prefix)
+		if in.N == nil {
+			out.RawString("null")
+		} else {
+			(*in.N).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Word\":"
+		out.RawString(prefix)
+		if in.Word == nil {
+			out.RawString("null")
+		} else {
+			(*in.Word).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Hdoc\":"
+		out.RawString(prefix)
+		if in.Hdoc == nil {
+			out.RawString("null")
+		} else {
+			(*in.Hdoc).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"OpPos\":"
+		out.RawString(prefix)
+		(in.OpPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Fd\":"
+		out.RawString(prefix)
+		(in.Fd).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Heredoc\":"
+		out.RawString(prefix)
+		if in.Heredoc == nil {
+			out.RawString("null")
+		} else {
+			(*in.Heredoc).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Redirect) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Redirect) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Redirect) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Redirect) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(in *jlexer.Lexer, out *QuotedValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = string(in.String())
+			}
+		case "Form":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Form = string(in.String())
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(QuoteError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(out *jwriter.Writer, in QuotedValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"Form\":"
+		out.RawString(prefix)
+		out.String(string(in.Form))
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotedValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotedValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotedValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotedValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(in *jlexer.Lexer, out *QuoteResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]QuotedValue, 0, 1)
+					} else {
+						out.Values = []QuotedValue{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v66 QuotedValue
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v66).UnmarshalEasyJSON(in)
+					}
+					out.Values = append(out.Values, v66)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Line":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Line = string(in.String())
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(QuoteError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(out *jwriter.Writer, in QuoteResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Values\":"
+		out.RawString(prefix[1:])
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v67, v68 := range in.Values {
+				if v67 > 0 {
+					out.RawByte(',')
+				}
+				(v68).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Line\":"
+		out.RawString(prefix)
+		out.String(string(in.Line))
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuoteResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuoteResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(in *jlexer.Lexer, out *QuoteError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ByteOffset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ByteOffset = int(in.Int())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(out *jwriter.Writer, in QuoteError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ByteOffset\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ByteOffset))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuoteError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuoteError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(in *jlexer.Lexer, out *QueryResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Matches":
+			if in.IsNull() {
+				in.Skip()
+				out.Matches = nil
+			} else {
+				in.Delim('[')
+				if out.Matches == nil {
+					if !in.IsDelim(']') {
+						out.Matches = make([]QueryMatch, 0, 0)
+					} else {
+						out.Matches = []QueryMatch{}
+					}
+				} else {
+					out.Matches = (out.Matches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v69 QueryMatch
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v69).UnmarshalEasyJSON(in)
+					}
+					out.Matches = append(out.Matches, v69)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Error = string(in.String())
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(out *jwriter.Writer, in QueryResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Matches\":"
+		out.RawString(prefix[1:])
+		if in.Matches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.Matches {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				(v71).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(in *jlexer.Lexer, out *QueryMatch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "Path":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Path = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(out *jwriter.Writer, in QueryMatch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryMatch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryMatch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryMatch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryMatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(in *jlexer.Lexer, out *Pos) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = uint(in.Uint())
+			}
+		case "Line":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Line = uint(in.Uint())
+			}
+		case "Col":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Col = uint(in.Uint())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(out *jwriter.Writer, in Pos) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Offset))
+	}
+	{
+		const prefix string = ",\"Line\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Line))
+	}
+	{
+		const prefix string = ",\"Col\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Col))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pos) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pos) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pos) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pos) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(in *jlexer.Lexer, out *PortabilityResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Issues":
+			if in.IsNull() {
+				in.Skip()
+				out.Issues = nil
+			} else {
+				in.Delim('[')
+				if out.Issues == nil {
+					if !in.IsDelim(']') {
+						out.Issues = make([]PortabilityIssue, 0, 0)
+					} else {
+						out.Issues = []PortabilityIssue{}
+					}
+				} else {
+					out.Issues = (out.Issues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 PortabilityIssue
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v72).UnmarshalEasyJSON(in)
+					}
+					out.Issues = append(out.Issues, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]string, 0, 4)
+					} else {
+						out.Variants = []string{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v73 = string(in.String())
+					}
+					out.Variants = append(out.Variants, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(out *jwriter.Writer, in PortabilityResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Issues\":"
+		out.RawString(prefix[1:])
+		if in.Issues == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v74, v75 := range in.Issues {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				(v75).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Variants\":"
+		out.RawString(prefix)
+		if in.Variants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v76, v77 := range in.Variants {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v77))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PortabilityResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PortabilityResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PortabilityResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PortabilityResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(in *jlexer.Lexer, out *PortabilityIssue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Construct":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Construct = string(in.String())
+			}
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]string, 0, 4)
+					} else {
+						out.Variants = []string{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v78 = string(in.String())
+					}
+					out.Variants = append(out.Variants, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(out *jwriter.Writer, in PortabilityIssue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Construct\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Construct))
+	}
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Variants\":"
+		out.RawString(prefix)
+		if in.Variants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v79, v80 := range in.Variants {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v80))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PortabilityIssue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PortabilityIssue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PortabilityIssue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PortabilityIssue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(in *jlexer.Lexer, out *Policy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "AllowCommands":
+			if in.IsNull() {
+				in.Skip()
+				out.AllowCommands = nil
+			} else {
+				in.Delim('[')
+				if out.AllowCommands == nil {
+					if !in.IsDelim(']') {
+						out.AllowCommands = make([]string, 0, 4)
+					} else {
+						out.AllowCommands = []string{}
+					}
+				} else {
+					out.AllowCommands = (out.AllowCommands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v81 = string(in.String())
+					}
+					out.AllowCommands = append(out.AllowCommands, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "DenyCommands":
+			if in.IsNull() {
+				in.Skip()
+				out.DenyCommands = nil
+			} else {
+				in.Delim('[')
+				if out.DenyCommands == nil {
+					if !in.IsDelim(']') {
+						out.DenyCommands = make([]string, 0, 4)
+					} else {
+						out.DenyCommands = []string{}
+					}
+				} else {
+					out.DenyCommands = (out.DenyCommands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v82 = string(in.String())
+					}
+					out.DenyCommands = append(out.DenyCommands, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "DenyBackground":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DenyBackground = bool(in.Bool())
+			}
+		case "DenyCoproc":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DenyCoproc = bool(in.Bool())
+			}
+		case "DenyRedirectPaths":
+			if in.IsNull() {
+				in.Skip()
+				out.DenyRedirectPaths = nil
+			} else {
+				in.Delim('[')
+				if out.DenyRedirectPaths == nil {
+					if !in.IsDelim(']') {
+						out.DenyRedirectPaths = make([]string, 0, 4)
+					} else {
+						out.DenyRedirectPaths = []string{}
+					}
+				} else {
+					out.DenyRedirectPaths = (out.DenyRedirectPaths)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v83 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v83 = string(in.String())
+					}
+					out.DenyRedirectPaths = append(out.DenyRedirectPaths, v83)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "DenyCmdSubst":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DenyCmdSubst = bool(in.Bool())
+			}
+		case "DenyDynamicEval":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DenyDynamicEval = bool(in.Bool())
+			}
+		case "MaxCallDepth":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxCallDepth = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(out *jwriter.Writer, in Policy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AllowCommands\":"
+		out.RawString(prefix[1:])
+		if in.AllowCommands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v84, v85 := range in.AllowCommands {
+				if v84 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v85))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"DenyCommands\":"
+		out.RawString(prefix)
+		if in.DenyCommands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.DenyCommands {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v87))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"DenyBackground\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DenyBackground))
+	}
+	{
+		const prefix string = ",\"DenyCoproc\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DenyCoproc))
+	}
+	{
+		const prefix string = ",\"DenyRedirectPaths\":"
+		out.RawString(prefix)
+		if in.DenyRedirectPaths == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v88, v89 := range in.DenyRedirectPaths {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v89))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"DenyCmdSubst\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DenyCmdSubst))
+	}
+	{
+		const prefix string = ",\"DenyDynamicEval\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DenyDynamicEval))
+	}
+	{
+		const prefix string = ",\"MaxCallDepth\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxCallDepth))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Policy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Policy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Policy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Policy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in *jlexer.Lexer, out *PatternResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Regexp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Regexp = string(in.String())
+			}
+		case "Flags":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Flags = string(in.String())
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(EvalError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out *jwriter.Writer, in PatternResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Regexp\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Regexp))
+	}
+	{
+		const prefix string = ",\"Flags\":"
+		out.RawString(prefix)
+		out.String(string(in.Flags))
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PatternResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PatternResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PatternResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PatternResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(in *jlexer.Lexer, out *ParseLimits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "MaxInputBytes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxInputBytes = int(in.Int())
+			}
+		case "MaxNodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxNodes = int(in.Int())
+			}
+		case "MaxDepth":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxDepth = int(in.Int())
+			}
+		case "MaxOutputBytes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxOutputBytes = int(in.Int())
+			}
+		case "MaxSteps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxSteps = int(in.Int())
+			}
+		case "MaxMillis":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxMillis = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(out *jwriter.Writer, in ParseLimits) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MaxInputBytes\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MaxInputBytes))
+	}
+	{
+		const prefix string = ",\"MaxNodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxNodes))
+	}
+	{
+		const prefix string = ",\"MaxDepth\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDepth))
+	}
+	{
+		const prefix string = ",\"MaxOutputBytes\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxOutputBytes))
+	}
+	{
+		const prefix string = ",\"MaxSteps\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxSteps))
+	}
+	{
+		const prefix string = ",\"MaxMillis\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMillis))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParseLimits) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParseLimits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParseLimits) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParseLimits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(in *jlexer.Lexer, out *ParseError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "Filename":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Filename = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Incomplete":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Incomplete = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(out *jwriter.Writer, in ParseError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix[1:])
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Filename\":"
+		out.RawString(prefix)
+		out.String(string(in.Filename))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Incomplete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Incomplete))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParseError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParseError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParseError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParseError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(in *jlexer.Lexer, out *ParenTest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "X":
+			if m, ok := out.X.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.X.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.X = in.Interface()
+			}
+		case "Lparen":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Lparen).UnmarshalEasyJSON(in)
+			}
+		case "Rparen":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Rparen).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(out *jwriter.Writer, in ParenTest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		if m, ok := in.X.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.X.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.X))
+		}
+	}
+	{
+		const prefix string = ",\"Lparen\":"
+		out.RawString(prefix)
+		(in.Lparen).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Rparen\":"
+		out.RawString(prefix)
+		(in.Rparen).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParenTest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParenTest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParenTest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParenTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor42(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(in *jlexer.Lexer, out *ParamExp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Short":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Short = bool(in.Bool())
+			}
+		case "Excl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Excl = bool(in.Bool())
+			}
+		case "Length":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Length = bool(in.Bool())
+			}
+		case "Width":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Width = bool(in.Bool())
+			}
+		case "Param":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Param).UnmarshalEasyJSON(in)
+			}
+		case "Index":
+			if m, ok := out.Index.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Index.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Index = in.Interface()
+			}
+		case "Slice":
+			if in.IsNull() {
+				in.Skip()
+				out.Slice = nil
+			} else {
+				if out.Slice == nil {
+					out.Slice = new(Slice)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Slice).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Repl":
+			if in.IsNull() {
+				in.Skip()
+				out.Repl = nil
+			} else {
+				if out.Repl == nil {
+					out.Repl = new(Replace)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Repl).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Names":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Names = string(in.String())
+			}
+		case "Exp":
+			if in.IsNull() {
+				in.Skip()
+				out.Exp = nil
+			} else {
+				if out.Exp == nil {
+					out.Exp = new(Expansion)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Exp).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Dollar":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Dollar).UnmarshalEasyJSON(in)
+			}
+		case "Rbrace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Rbrace).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(out *jwriter.Writer, in ParamExp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Short\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Short))
+	}
+	{
+		const prefix string = ",\"Excl\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Excl))
+	}
+	{
+		const prefix string = ",\"Length\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Length))
+	}
+	{
+		const prefix string = ",\"Width\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Width))
+	}
+	{
+		const prefix string = ",\"Param\":"
+		out.RawString(prefix)
+		(in.Param).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Index\":"
+		out.RawString(prefix)
+		if m, ok := in.Index.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Index.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Index))
+		}
+	}
+	{
+		const prefix string = ",\"Slice\":"
+		out.RawString(prefix)
+		if in.Slice == nil {
+			out.RawString("null")
+		} else {
+			(*in.Slice).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Repl\":"
+		out.RawString(prefix)
+		if in.Repl == nil {
+			out.RawString("null")
+		} else {
+			(*in.Repl).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Names\":"
+		out.RawString(prefix)
+		out.String(string(in.Names))
+	}
+	{
+		const prefix string = ",\"Exp\":"
+		out.RawString(prefix)
+		if in.Exp == nil {
+			out.RawString("null")
+		} else {
+			(*in.Exp).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Dollar\":"
+		out.RawString(prefix)
+		(in.Dollar).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Rbrace\":"
+		out.RawString(prefix)
+		(in.Rbrace).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParamExp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParamExp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParamExp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParamExp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor43(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(in *jlexer.Lexer, out *NodeInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+				out.Pos = nil
+			} else {
+				if out.Pos == nil {
+					out.Pos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Pos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.End).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(out *jwriter.Writer, in NodeInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		if in.Pos == nil {
+			out.RawString("null")
+		} else {
+			(*in.Pos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			(*in.End).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor44(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(in *jlexer.Lexer, out *Node) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(out *jwriter.Writer, in Node) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix[1:])
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Node) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Node) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Node) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Node) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor45(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(in *jlexer.Lexer, out *Lit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = string(in.String())
+			}
+		case "ValuePos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ValuePos).UnmarshalEasyJSON(in)
+			}
+		case "ValueEnd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ValueEnd).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(out *jwriter.Writer, in Lit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"ValuePos\":"
+		out.RawString(prefix)
+		(in.ValuePos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ValueEnd\":"
+		out.RawString(prefix)
+		(in.ValueEnd).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Lit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Lit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Lit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Lit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor46(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(in *jlexer.Lexer, out *LintRulesResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				in.Delim('[')
+				if out.Rules == nil {
+					if !in.IsDelim(']') {
+						out.Rules = make([]LintRuleInfo, 0, 1)
+					} else {
+						out.Rules = []LintRuleInfo{}
+					}
+				} else {
+					out.Rules = (out.Rules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v90 LintRuleInfo
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v90).UnmarshalEasyJSON(in)
+					}
+					out.Rules = append(out.Rules, v90)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(out *jwriter.Writer, in LintRulesResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Rules\":"
+		out.RawString(prefix[1:])
+		if in.Rules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.Rules {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				(v92).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LintRulesResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LintRulesResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LintRulesResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LintRulesResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor47(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(in *jlexer.Lexer, out *LintRuleInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
+		case "Severity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Severity = string(in.String())
+			}
+		case "Summary":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Summary = string(in.String())
+			}
+		case "Fixable":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Fixable = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(out *jwriter.Writer, in LintRuleInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"Severity\":"
+		out.RawString(prefix)
+		out.String(string(in.Severity))
+	}
+	{
+		const prefix string = ",\"Summary\":"
+		out.RawString(prefix)
+		out.String(string(in.Summary))
+	}
+	{
+		const prefix string = ",\"Fixable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Fixable))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LintRuleInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LintRuleInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LintRuleInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LintRuleInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor48(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(in *jlexer.Lexer, out *LintResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Diagnostics":
+			if in.IsNull() {
+				in.Skip()
+				out.Diagnostics = nil
+			} else {
+				in.Delim('[')
+				if out.Diagnostics == nil {
+					if !in.IsDelim(']') {
+						out.Diagnostics = make([]Diagnostic, 0, 0)
+					} else {
+						out.Diagnostics = []Diagnostic{}
+					}
+				} else {
+					out.Diagnostics = (out.Diagnostics)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v93 Diagnostic
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v93).UnmarshalEasyJSON(in)
+					}
+					out.Diagnostics = append(out.Diagnostics, v93)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(out *jwriter.Writer, in LintResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Diagnostics\":"
+		out.RawString(prefix[1:])
+		if in.Diagnostics == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.Diagnostics {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				(v95).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LintResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LintResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LintResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LintResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor49(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(in *jlexer.Lexer, out *LintOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Modules":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Modules = make(map[string][]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v96 []string
+					if in.IsNull() {
+						in.Skip()
+						v96 = nil
+					} else {
+						in.Delim('[')
+						if v96 == nil {
+							if !in.IsDelim(']') {
+								v96 = make([]string, 0, 4)
+							} else {
+								v96 = []string{}
+							}
+						} else {
+							v96 = (v96)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v97 string
+							if in.IsNull() {
+								in.Skip()
+							} else {
+								v97 = string(in.String())
+							}
+							v96 = append(v96, v97)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Modules)[key] = v96
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Disable":
+			if in.IsNull() {
+				in.Skip()
+				out.Disable = nil
+			} else {
+				in.Delim('[')
+				if out.Disable == nil {
+					if !in.IsDelim(']') {
+						out.Disable = make([]string, 0, 4)
+					} else {
+						out.Disable = []string{}
+					}
+				} else {
+					out.Disable = (out.Disable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v98 = string(in.String())
+					}
+					out.Disable = append(out.Disable, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Severities":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Severities = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v99 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v99 = string(in.String())
+					}
+					(out.Severities)[key] = v99
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(out *jwriter.Writer, in LintOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Modules\":"
+		out.RawString(prefix[1:])
+		if in.Modules == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v100First := true
+			for v100Name, v100Value := range in.Modules {
+				if v100First {
+					v100First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v100Name))
+				out.RawByte(':')
+				if v100Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v101, v102 := range v100Value {
+						if v101 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v102))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Disable\":"
+		out.RawString(prefix)
+		if in.Disable == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v103, v104 := range in.Disable {
+				if v103 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v104))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Severities\":"
+		out.RawString(prefix)
+		if in.Severities == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v105First := true
+			for v105Name, v105Value := range in.Severities {
+				if v105First {
+					v105First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v105Name))
+				out.RawByte(':')
+				out.String(string(v105Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LintOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LintOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LintOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LintOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor50(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(in *jlexer.Lexer, out *LintFix) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Edits":
+			if in.IsNull() {
+				in.Skip()
+				out.Edits = nil
+			} else {
+				in.Delim('[')
+				if out.Edits == nil {
+					if !in.IsDelim(']') {
+						out.Edits = make([]TextEdit, 0, 1)
+					} else {
+						out.Edits = []TextEdit{}
+					}
+				} else {
+					out.Edits = (out.Edits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 TextEdit
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v106).UnmarshalEasyJSON(in)
+					}
+					out.Edits = append(out.Edits, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(out *jwriter.Writer, in LintFix) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Edits\":"
+		out.RawString(prefix)
+		if in.Edits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v107, v108 := range in.Edits {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				(v108).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LintFix) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LintFix) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LintFix) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LintFix) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor51(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(in *jlexer.Lexer, out *LineTableResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]LineEntry, 0, 0)
+					} else {
+						out.Entries = []LineEntry{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v109 LineEntry
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v109).UnmarshalEasyJSON(in)
+					}
+					out.Entries = append(out.Entries, v109)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Branches":
+			if in.IsNull() {
+				in.Skip()
+				out.Branches = nil
+			} else {
+				in.Delim('[')
+				if out.Branches == nil {
+					if !in.IsDelim(']') {
+						out.Branches = make([]Branch, 0, 0)
+					} else {
+						out.Branches = []Branch{}
+					}
+				} else {
+					out.Branches = (out.Branches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v110 Branch
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v110).UnmarshalEasyJSON(in)
+					}
+					out.Branches = append(out.Branches, v110)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(out *jwriter.Writer, in LineTableResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Entries\":"
+		out.RawString(prefix[1:])
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v111, v112 := range in.Entries {
+				if v111 > 0 {
+					out.RawByte(',')
+				}
+				(v112).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Branches\":"
+		out.RawString(prefix)
+		if in.Branches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v113, v114 := range in.Branches {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				(v114).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LineTableResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LineTableResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LineTableResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LineTableResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor52(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(in *jlexer.Lexer, out *LineEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "Function":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Function = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(out *jwriter.Writer, in LineEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Function\":"
+		out.RawString(prefix)
+		out.String(string(in.Function))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LineEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LineEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LineEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LineEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor53(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(in *jlexer.Lexer, out *LimitError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int(in.Int())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(out *jwriter.Writer, in LimitError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LimitError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LimitError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LimitError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LimitError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor54(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(in *jlexer.Lexer, out *LetClause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Exprs":
+			if m, ok := out.Exprs.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Exprs.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Exprs = in.Interface()
+			}
+		case "Let":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Let).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(out *jwriter.Writer, in LetClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Exprs\":"
+		out.RawString(prefix)
+		if m, ok := in.Exprs.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Exprs.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Exprs))
+		}
+	}
+	{
+		const prefix string = ",\"Let\":"
+		out.RawString(prefix)
+		(in.Let).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LetClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LetClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LetClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LetClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor55(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(in *jlexer.Lexer, out *IfClause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Cond":
+			if in.IsNull() {
+				in.Skip()
+				out.Cond = nil
+			} else {
+				in.Delim('[')
+				if out.Cond == nil {
+					if !in.IsDelim(']') {
+						out.Cond = make([]Stmt, 0, 0)
+					} else {
+						out.Cond = []Stmt{}
+					}
+				} else {
+					out.Cond = (out.Cond)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v115 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v115).UnmarshalEasyJSON(in)
+					}
+					out.Cond = append(out.Cond, v115)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Then":
+			if in.IsNull() {
+				in.Skip()
+				out.Then = nil
+			} else {
+				in.Delim('[')
+				if out.Then == nil {
+					if !in.IsDelim(']') {
+						out.Then = make([]Stmt, 0, 0)
+					} else {
+						out.Then = []Stmt{}
+					}
+				} else {
+					out.Then = (out.Then)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v116 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v116).UnmarshalEasyJSON(in)
+					}
+					out.Then = append(out.Then, v116)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Else":
+			if in.IsNull() {
+				in.Skip()
+				out.Else = nil
+			} else {
+				if out.Else == nil {
+					out.Else = new(IfClause)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Else).UnmarshalEasyJSON(in)
+				}
+			}
+		case "ThenPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ThenPos).UnmarshalEasyJSON(in)
+			}
+		case "FiPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.FiPos).UnmarshalEasyJSON(in)
+			}
+		case "CondLast":
+			if in.IsNull() {
+				in.Skip()
+				out.CondLast = nil
+			} else {
+				in.Delim('[')
+				if out.CondLast == nil {
+					if !in.IsDelim(']') {
+						out.CondLast = make([]Comment, 0, 0)
+					} else {
+						out.CondLast = []Comment{}
+					}
+				} else {
+					out.CondLast = (out.CondLast)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v117 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v117).UnmarshalEasyJSON(in)
+					}
+					out.CondLast = append(out.CondLast, v117)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ThenLast":
+			if in.IsNull() {
+				in.Skip()
+				out.ThenLast = nil
+			} else {
+				in.Delim('[')
+				if out.ThenLast == nil {
+					if !in.IsDelim(']') {
+						out.ThenLast = make([]Comment, 0, 0)
+					} else {
+						out.ThenLast = []Comment{}
+					}
+				} else {
+					out.ThenLast = (out.ThenLast)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v118 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v118).UnmarshalEasyJSON(in)
+					}
+					out.ThenLast = append(out.ThenLast, v118)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Last":
+			if in.IsNull() {
+				in.Skip()
+				out.Last = nil
+			} else {
+				in.Delim('[')
+				if out.Last == nil {
+					if !in.IsDelim(']') {
+						out.Last = make([]Comment, 0, 0)
+					} else {
+						out.Last = []Comment{}
+					}
+				} else {
+					out.Last = (out.Last)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v119 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v119).UnmarshalEasyJSON(in)
+					}
+					out.Last = append(out.Last, v119)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(out *jwriter.Writer, in IfClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Cond\":"
+		out.RawString(prefix)
+		if in.Cond == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v120, v121 := range in.Cond {
+				if v120 > 0 {
+					out.RawByte(',')
+				}
+				(v121).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Then\":"
+		out.RawString(prefix)
+		if in.Then == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v122, v123 := range in.Then {
+				if v122 > 0 {
+					out.RawByte(',')
+				}
+				(v123).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Else\":"
+		out.RawString(prefix)
+		if in.Else == nil {
+			out.RawString("null")
+		} else {
+			(*in.Else).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"ThenPos\":"
+		out.RawString(prefix)
+		(in.ThenPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"FiPos\":"
+		out.RawString(prefix)
+		(in.FiPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"CondLast\":"
+		out.RawString(prefix)
+		if in.CondLast == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v124, v125 := range in.CondLast {
+				if v124 > 0 {
+					out.RawByte(',')
+				}
+				(v125).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ThenLast\":"
+		out.RawString(prefix)
+		if in.ThenLast == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v126, v127 := range in.ThenLast {
+				if v126 > 0 {
+					out.RawByte(',')
+				}
+				(v127).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Last\":"
+		out.RawString(prefix)
+		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v128, v129 := range in.Last {
+				if v128 > 0 {
+					out.RawByte(',')
+				}
+				(v129).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IfClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IfClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IfClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IfClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor56(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(in *jlexer.Lexer, out *Heredoc) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Delimiter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Delimiter = string(in.String())
+			}
+		case "Quoted":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quoted = bool(in.Bool())
+			}
+		case "StripTabs":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StripTabs = bool(in.Bool())
+			}
+		case "BodyPos":
+			if in.IsNull() {
+				in.Skip()
+				out.BodyPos = nil
+			} else {
+				if out.BodyPos == nil {
+					out.BodyPos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.BodyPos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "BodyEnd":
+			if in.IsNull() {
+				in.Skip()
+				out.BodyEnd = nil
+			} else {
+				if out.BodyEnd == nil {
+					out.BodyEnd = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.BodyEnd).UnmarshalEasyJSON(in)
+				}
+			}
+		case "TerminatorPos":
+			if in.IsNull() {
+				in.Skip()
+				out.TerminatorPos = nil
+			} else {
+				if out.TerminatorPos == nil {
+					out.TerminatorPos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.TerminatorPos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "TerminatorEnd":
+			if in.IsNull() {
+				in.Skip()
+				out.TerminatorEnd = nil
+			} else {
+				if out.TerminatorEnd == nil {
+					out.TerminatorEnd = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.TerminatorEnd).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Body":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Body = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(out *jwriter.Writer, in Heredoc) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Delimiter\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Delimiter))
+	}
+	{
+		const prefix string = ",\"Quoted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Quoted))
+	}
+	{
+		const prefix string = ",\"StripTabs\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StripTabs))
+	}
+	{
+		const prefix string = ",\"BodyPos\":"
+		out.RawString(prefix)
+		if in.BodyPos == nil {
+			out.RawString("null")
+		} else {
+			(*in.BodyPos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"BodyEnd\":"
+		out.RawString(prefix)
+		if in.BodyEnd == nil {
+			out.RawString("null")
+		} else {
+			(*in.BodyEnd).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"TerminatorPos\":"
+		out.RawString(prefix)
+		if in.TerminatorPos == nil {
+			out.RawString("null")
+		} else {
+			(*in.TerminatorPos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"TerminatorEnd\":"
+		out.RawString(prefix)
+		if in.TerminatorEnd == nil {
+			out.RawString("null")
+		} else {
+			(*in.TerminatorEnd).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Heredoc) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Heredoc) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Heredoc) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Heredoc) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor57(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(in *jlexer.Lexer, out *FuncDecl) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "RsrvWord":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RsrvWord = bool(in.Bool())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Name).UnmarshalEasyJSON(in)
+			}
+		case "Body":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Body).UnmarshalEasyJSON(in)
+			}
+		case "Position":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Position).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(out *jwriter.Writer, in FuncDecl) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"RsrvWord\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RsrvWord))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		(in.Name).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Body\":"
+		out.RawString(prefix)
+		(in.Body).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Position\":"
+		out.RawString(prefix)
+		(in.Position).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FuncDecl) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FuncDecl) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FuncDecl) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FuncDecl) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor58(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(in *jlexer.Lexer, out *ForClause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Select":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Select = bool(in.Bool())
+			}
+		case "Loop":
+			if m, ok := out.Loop.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Loop.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Loop = in.Interface()
+			}
+		case "Do":
+			if in.IsNull() {
+				in.Skip()
+				out.Do = nil
+			} else {
+				in.Delim('[')
+				if out.Do == nil {
+					if !in.IsDelim(']') {
+						out.Do = make([]Stmt, 0, 0)
+					} else {
+						out.Do = []Stmt{}
+					}
+				} else {
+					out.Do = (out.Do)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v130 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v130).UnmarshalEasyJSON(in)
+					}
+					out.Do = append(out.Do, v130)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ForPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ForPos).UnmarshalEasyJSON(in)
+			}
+		case "DoPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.DoPos).UnmarshalEasyJSON(in)
+			}
+		case "DonePos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.DonePos).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(out *jwriter.Writer, in ForClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Select\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Select))
+	}
+	{
+		const prefix string = ",\"Loop\":"
+		out.RawString(prefix)
+		if m, ok := in.Loop.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Loop.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Loop))
+		}
+	}
+	{
+		const prefix string = ",\"Do\":"
+		out.RawString(prefix)
+		if in.Do == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v131, v132 := range in.Do {
+				if v131 > 0 {
+					out.RawByte(',')
+				}
+				(v132).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ForPos\":"
+		out.RawString(prefix)
+		(in.ForPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"DoPos\":"
+		out.RawString(prefix)
+		(in.DoPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"DonePos\":"
+		out.RawString(prefix)
+		(in.DonePos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ForClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ForClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ForClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ForClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor59(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(in *jlexer.Lexer, out *File) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Stmts":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmts = nil
+			} else {
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]Stmt, 0, 0)
+					} else {
+						out.Stmts = []Stmt{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v133 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v133).UnmarshalEasyJSON(in)
+					}
+					out.Stmts = append(out.Stmts, v133)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Last":
+			if in.IsNull() {
+				in.Skip()
+				out.Last = nil
+			} else {
+				in.Delim('[')
+				if out.Last == nil {
+					if !in.IsDelim(']') {
+						out.Last = make([]Comment, 0, 0)
+					} else {
+						out.Last = []Comment{}
+					}
+				} else {
+					out.Last = (out.Last)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v134 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v134).UnmarshalEasyJSON(in)
+					}
+					out.Last = append(out.Last, v134)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(out *jwriter.Writer, in File) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Stmts\":"
+		out.RawString(prefix)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v135, v136 := range in.Stmts {
+				if v135 > 0 {
+					out.RawByte(',')
+				}
+				(v136).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Last\":"
+		out.RawString(prefix)
+		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v137, v138 := range in.Last {
+				if v137 > 0 {
+					out.RawByte(',')
+				}
+				(v138).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v File) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v File) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *File) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *File) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor60(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(in *jlexer.Lexer, out *Expansion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "Word":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Word).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(out *jwriter.Writer, in Expansion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"Word\":"
+		out.RawString(prefix)
+		(in.Word).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Expansion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Expansion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Expansion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Expansion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor61(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(in *jlexer.Lexer, out *ExpandResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Fields":
+			if in.IsNull() {
+				in.Skip()
+				out.Fields = nil
+			} else {
+				in.Delim('[')
+				if out.Fields == nil {
+					if !in.IsDelim(']') {
+						out.Fields = make([]string, 0, 4)
+					} else {
+						out.Fields = []string{}
+					}
+				} else {
+					out.Fields = (out.Fields)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v139 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v139 = string(in.String())
+					}
+					out.Fields = append(out.Fields, v139)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Assigns":
+			if in.IsNull() {
+				in.Skip()
+				out.Assigns = nil
+			} else {
+				in.Delim('[')
+				if out.Assigns == nil {
+					if !in.IsDelim(']') {
+						out.Assigns = make([]VarAssign, 0, 0)
+					} else {
+						out.Assigns = []VarAssign{}
+					}
+				} else {
+					out.Assigns = (out.Assigns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v140 VarAssign
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v140).UnmarshalEasyJSON(in)
+					}
+					out.Assigns = append(out.Assigns, v140)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(EvalError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(out *jwriter.Writer, in ExpandResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Fields\":"
+		out.RawString(prefix[1:])
+		if in.Fields == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v141, v142 := range in.Fields {
+				if v141 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v142))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Assigns\":"
+		out.RawString(prefix)
+		if in.Assigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v143, v144 := range in.Assigns {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				(v144).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExpandResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExpandResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExpandResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExpandResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor62(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(in *jlexer.Lexer, out *EvalResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = int64(in.Int64())
+			}
+		case "Status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = int(in.Int())
+			}
+		case "Assigns":
+			if in.IsNull() {
+				in.Skip()
+				out.Assigns = nil
+			} else {
+				in.Delim('[')
+				if out.Assigns == nil {
+					if !in.IsDelim(']') {
+						out.Assigns = make([]VarAssign, 0, 0)
+					} else {
+						out.Assigns = []VarAssign{}
+					}
+				} else {
+					out.Assigns = (out.Assigns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v145 VarAssign
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v145).UnmarshalEasyJSON(in)
+					}
+					out.Assigns = append(out.Assigns, v145)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(EvalError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Error).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(out *jwriter.Writer, in EvalResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Value))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"Assigns\":"
+		out.RawString(prefix)
+		if in.Assigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v146, v147 := range in.Assigns {
+				if v146 > 0 {
+					out.RawByte(',')
+				}
+				(v147).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EvalResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EvalResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EvalResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EvalResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor63(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(in *jlexer.Lexer, out *EvalError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(out *jwriter.Writer, in EvalError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EvalError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EvalError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EvalError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EvalError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor64(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(in *jlexer.Lexer, out *DocsResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Docs":
+			if in.IsNull() {
+				in.Skip()
+				out.Docs = nil
+			} else {
+				in.Delim('[')
+				if out.Docs == nil {
+					if !in.IsDelim(']') {
+						out.Docs = make([]DocEntry, 0, 0)
+					} else {
+						out.Docs = []DocEntry{}
+					}
+				} else {
+					out.Docs = (out.Docs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v148 DocEntry
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v148).UnmarshalEasyJSON(in)
+					}
+					out.Docs = append(out.Docs, v148)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(out *jwriter.Writer, in DocsResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Docs\":"
+		out.RawString(prefix[1:])
+		if in.Docs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v149, v150 := range in.Docs {
+				if v149 > 0 {
+					out.RawByte(',')
+				}
+				(v150).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DocsResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DocsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DocsResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DocsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor65(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(in *jlexer.Lexer, out *DocTag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Tag":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tag = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(out *jwriter.Writer, in DocTag) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Tag\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Tag))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DocTag) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DocTag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DocTag) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DocTag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor66(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(in *jlexer.Lexer, out *DocEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Function":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Function = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "Args":
+			if in.IsNull() {
+				in.Skip()
+				out.Args = nil
+			} else {
+				in.Delim('[')
+				if out.Args == nil {
+					if !in.IsDelim(']') {
+						out.Args = make([]DocArg, 0, 2)
+					} else {
+						out.Args = []DocArg{}
+					}
+				} else {
+					out.Args = (out.Args)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v151 DocArg
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v151).UnmarshalEasyJSON(in)
+					}
+					out.Args = append(out.Args, v151)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Examples":
+			if in.IsNull() {
+				in.Skip()
+				out.Examples = nil
+			} else {
+				in.Delim('[')
+				if out.Examples == nil {
+					if !in.IsDelim(']') {
+						out.Examples = make([]string, 0, 4)
+					} else {
+						out.Examples = []string{}
+					}
+				} else {
+					out.Examples = (out.Examples)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v152 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v152 = string(in.String())
+					}
+					out.Examples = append(out.Examples, v152)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Returns":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Returns = string(in.String())
+			}
+		case "Tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]DocTag, 0, 2)
+					} else {
+						out.Tags = []DocTag{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v153 DocTag
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v153).UnmarshalEasyJSON(in)
+					}
+					out.Tags = append(out.Tags, v153)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "CommentPos":
+			if in.IsNull() {
+				in.Skip()
+				out.CommentPos = nil
+			} else {
+				if out.CommentPos == nil {
+					out.CommentPos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.CommentPos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "CommentEnd":
+			if in.IsNull() {
+				in.Skip()
+				out.CommentEnd = nil
+			} else {
+				if out.CommentEnd == nil {
+					out.CommentEnd = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.CommentEnd).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(out *jwriter.Writer, in DocEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Function\":"
+		out.RawString(prefix)
+		out.String(string(in.Function))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"Args\":"
+		out.RawString(prefix)
+		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v154, v155 := range in.Args {
+				if v154 > 0 {
+					out.RawByte(',')
+				}
+				(v155).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Examples\":"
+		out.RawString(prefix)
+		if in.Examples == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v156, v157 := range in.Examples {
+				if v156 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v157))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Returns\":"
+		out.RawString(prefix)
+		out.String(string(in.Returns))
+	}
+	{
+		const prefix string = ",\"Tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v158, v159 := range in.Tags {
+				if v158 > 0 {
+					out.RawByte(',')
+				}
+				(v159).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"CommentPos\":"
+		out.RawString(prefix)
+		if in.CommentPos == nil {
+			out.RawString("null")
+		} else {
+			(*in.CommentPos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"CommentEnd\":"
+		out.RawString(prefix)
+		if in.CommentEnd == nil {
+			out.RawString("null")
+		} else {
+			(*in.CommentEnd).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DocEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DocEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DocEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DocEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor67(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(in *jlexer.Lexer, out *DocArg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(out *jwriter.Writer, in DocArg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DocArg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DocArg) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DocArg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DocArg) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor68(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(in *jlexer.Lexer, out *DiffResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]DiffChange, 0, 0)
+					} else {
+						out.Changes = []DiffChange{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v160 DiffChange
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v160).UnmarshalEasyJSON(in)
+					}
+					out.Changes = append(out.Changes, v160)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "ParseErrorInOld":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParseErrorInOld = bool(in.Bool())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(out *jwriter.Writer, in DiffResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Changes\":"
+		out.RawString(prefix[1:])
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v161, v162 := range in.Changes {
+				if v161 > 0 {
+					out.RawByte(',')
+				}
+				(v162).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"ParseErrorInOld\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ParseErrorInOld))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiffResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiffResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiffResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiffResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor69(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(in *jlexer.Lexer, out *DiffChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Change":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Change = string(in.String())
+			}
+		case "OldPos":
+			if in.IsNull() {
+				in.Skip()
+				out.OldPos = nil
+			} else {
+				if out.OldPos == nil {
+					out.OldPos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.OldPos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "OldEnd":
+			if in.IsNull() {
+				in.Skip()
+				out.OldEnd = nil
+			} else {
+				if out.OldEnd == nil {
+					out.OldEnd = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.OldEnd).UnmarshalEasyJSON(in)
+				}
+			}
+		case "NewPos":
+			if in.IsNull() {
+				in.Skip()
+				out.NewPos = nil
+			} else {
+				if out.NewPos == nil {
+					out.NewPos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.NewPos).UnmarshalEasyJSON(in)
+				}
+			}
+		case "NewEnd":
+			if in.IsNull() {
+				in.Skip()
+				out.NewEnd = nil
+			} else {
+				if out.NewEnd == nil {
+					out.NewEnd = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.NewEnd).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(out *jwriter.Writer, in DiffChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Change\":"
+		out.RawString(prefix)
+		out.String(string(in.Change))
+	}
+	{
+		const prefix string = ",\"OldPos\":"
+		out.RawString(prefix)
+		if in.OldPos == nil {
+			out.RawString("null")
+		} else {
+			(*in.OldPos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"OldEnd\":"
+		out.RawString(prefix)
+		if in.OldEnd == nil {
+			out.RawString("null")
+		} else {
+			(*in.OldEnd).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"NewPos\":"
+		out.RawString(prefix)
+		if in.NewPos == nil {
+			out.RawString("null")
+		} else {
+			(*in.NewPos).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"NewEnd\":"
+		out.RawString(prefix)
+		if in.NewEnd == nil {
+			out.RawString("null")
+		} else {
+			(*in.NewEnd).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiffChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiffChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiffChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiffChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor70(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(in *jlexer.Lexer, out *Diagnostic) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Rule":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Rule = string(in.String())
+			}
+		case "Severity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Severity = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Fix":
+			if in.IsNull() {
+				in.Skip()
+				out.Fix = nil
+			} else {
+				if out.Fix == nil {
+					out.Fix = new(LintFix)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Fix).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(out *jwriter.Writer, in Diagnostic) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Rule\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Rule))
+	}
+	{
+		const prefix string = ",\"Severity\":"
+		out.RawString(prefix)
+		out.String(string(in.Severity))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Fix\":"
+		out.RawString(prefix)
+		if in.Fix == nil {
+			out.RawString("null")
+		} else {
+			(*in.Fix).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Diagnostic) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Diagnostic) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Diagnostic) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Diagnostic) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor71(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(in *jlexer.Lexer, out *DesugarResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "file":
+			if in.IsNull() {
+				in.Skip()
+				out.File = nil
+			} else {
+				if out.File == nil {
+					out.File = new(File)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.File).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]Rewrite, 0, 0)
+					} else {
+						out.Changes = []Rewrite{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v163 Rewrite
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v163).UnmarshalEasyJSON(in)
+					}
+					out.Changes = append(out.Changes, v163)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(out *jwriter.Writer, in DesugarResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"file\":"
+		out.RawString(prefix[1:])
+		if in.File == nil {
+			out.RawString("null")
+		} else {
+			(*in.File).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Changes\":"
+		out.RawString(prefix)
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.Changes {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				(v165).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DesugarResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DesugarResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DesugarResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DesugarResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor72(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(in *jlexer.Lexer, out *DepsRef) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "From":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.From = string(in.String())
+			}
+		case "Function":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Function = string(in.String())
+			}
+		case "Target":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Target = string(in.String())
+			}
+		case "DefinedIn":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DefinedIn = string(in.String())
+			}
+		case "Module":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Module = string(in.String())
+			}
+		case "Dynamic":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dynamic = bool(in.Bool())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(out *jwriter.Writer, in DepsRef) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"From\":"
+		out.RawString(prefix)
+		out.String(string(in.From))
+	}
+	{
+		const prefix string = ",\"Function\":"
+		out.RawString(prefix)
+		out.String(string(in.Function))
+	}
+	{
+		const prefix string = ",\"Target\":"
+		out.RawString(prefix)
+		out.String(string(in.Target))
+	}
+	{
+		const prefix string = ",\"DefinedIn\":"
+		out.RawString(prefix)
+		out.String(string(in.DefinedIn))
+	}
+	{
+		const prefix string = ",\"Module\":"
+		out.RawString(prefix)
+		out.String(string(in.Module))
+	}
+	{
+		const prefix string = ",\"Dynamic\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dynamic))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepsRef) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepsRef) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepsRef) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepsRef) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor73(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(in *jlexer.Lexer, out *DepsInputFile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Path":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Path = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(out *jwriter.Writer, in DepsInputFile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepsInputFile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepsInputFile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepsInputFile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepsInputFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor74(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(in *jlexer.Lexer, out *DepsInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Files":
+			if in.IsNull() {
+				in.Skip()
+				out.Files = nil
+			} else {
+				in.Delim('[')
+				if out.Files == nil {
+					if !in.IsDelim(']') {
+						out.Files = make([]DepsInputFile, 0, 2)
+					} else {
+						out.Files = []DepsInputFile{}
+					}
+				} else {
+					out.Files = (out.Files)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v166 DepsInputFile
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v166).UnmarshalEasyJSON(in)
+					}
+					out.Files = append(out.Files, v166)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Modules":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Modules = make(map[string][]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v167 []string
+					if in.IsNull() {
+						in.Skip()
+						v167 = nil
+					} else {
+						in.Delim('[')
+						if v167 == nil {
+							if !in.IsDelim(']') {
+								v167 = make([]string, 0, 4)
+							} else {
+								v167 = []string{}
+							}
+						} else {
+							v167 = (v167)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v168 string
+							if in.IsNull() {
+								in.Skip()
+							} else {
+								v168 = string(in.String())
+							}
+							v167 = append(v167, v168)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Modules)[key] = v167
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Variant":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Variant = int(in.Int())
+			}
+		case "Cwd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Cwd = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(out *jwriter.Writer, in DepsInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Files\":"
+		out.RawString(prefix[1:])
+		if in.Files == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v169, v170 := range in.Files {
+				if v169 > 0 {
+					out.RawByte(',')
+				}
+				(v170).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Modules\":"
+		out.RawString(prefix)
+		if in.Modules == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v171First := true
+			for v171Name, v171Value := range in.Modules {
+				if v171First {
+					v171First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v171Name))
+				out.RawByte(':')
+				if v171Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v172, v173 := range v171Value {
+						if v172 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v173))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Variant\":"
+		out.RawString(prefix)
+		out.Int(int(in.Variant))
+	}
+	{
+		const prefix string = ",\"Cwd\":"
+		out.RawString(prefix)
+		out.String(string(in.Cwd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepsInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepsInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepsInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepsInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor75(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(in *jlexer.Lexer, out *DepsGraph) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Functions":
+			if in.IsNull() {
+				in.Skip()
+				out.Functions = nil
+			} else {
+				in.Delim('[')
+				if out.Functions == nil {
+					if !in.IsDelim(']') {
+						out.Functions = make([]DepsFunction, 0, 0)
+					} else {
+						out.Functions = []DepsFunction{}
+					}
+				} else {
+					out.Functions = (out.Functions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v174 DepsFunction
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v174).UnmarshalEasyJSON(in)
+					}
+					out.Functions = append(out.Functions, v174)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Refs":
+			if in.IsNull() {
+				in.Skip()
+				out.Refs = nil
+			} else {
+				in.Delim('[')
+				if out.Refs == nil {
+					if !in.IsDelim(']') {
+						out.Refs = make([]DepsRef, 0, 0)
+					} else {
+						out.Refs = []DepsRef{}
+					}
+				} else {
+					out.Refs = (out.Refs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v175 DepsRef
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v175).UnmarshalEasyJSON(in)
+					}
+					out.Refs = append(out.Refs, v175)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "LoadOrder":
+			if in.IsNull() {
+				in.Skip()
+				out.LoadOrder = nil
+			} else {
+				in.Delim('[')
+				if out.LoadOrder == nil {
+					if !in.IsDelim(']') {
+						out.LoadOrder = make([]string, 0, 4)
+					} else {
+						out.LoadOrder = []string{}
+					}
+				} else {
+					out.LoadOrder = (out.LoadOrder)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v176 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v176 = string(in.String())
+					}
+					out.LoadOrder = append(out.LoadOrder, v176)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ParseErrors":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseErrors = nil
+			} else {
+				in.Delim('[')
+				if out.ParseErrors == nil {
+					if !in.IsDelim(']') {
+						out.ParseErrors = make([]*ParseError, 0, 8)
+					} else {
+						out.ParseErrors = []*ParseError{}
+					}
+				} else {
+					out.ParseErrors = (out.ParseErrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v177 *ParseError
+					if in.IsNull() {
+						in.Skip()
+						v177 = nil
+					} else {
+						if v177 == nil {
+							v177 = new(ParseError)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v177).UnmarshalEasyJSON(in)
+						}
+					}
+					out.ParseErrors = append(out.ParseErrors, v177)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(out *jwriter.Writer, in DepsGraph) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Functions\":"
+		out.RawString(prefix[1:])
+		if in.Functions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v178, v179 := range in.Functions {
+				if v178 > 0 {
+					out.RawByte(',')
+				}
+				(v179).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Refs\":"
+		out.RawString(prefix)
+		if in.Refs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v180, v181 := range in.Refs {
+				if v180 > 0 {
+					out.RawByte(',')
+				}
+				(v181).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"LoadOrder\":"
+		out.RawString(prefix)
+		if in.LoadOrder == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v182, v183 := range in.LoadOrder {
+				if v182 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v183))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ParseErrors\":"
+		out.RawString(prefix)
+		if in.ParseErrors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v184, v185 := range in.ParseErrors {
+				if v184 > 0 {
+					out.RawByte(',')
+				}
+				if v185 == nil {
+					out.RawString("null")
+				} else {
+					(*v185).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepsGraph) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepsGraph) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepsGraph) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepsGraph) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor76(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(in *jlexer.Lexer, out *DepsFunction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "File":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.File = string(in.String())
+			}
+		case "Module":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Module = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Callers":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Callers = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(out *jwriter.Writer, in DepsFunction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"File\":"
+		out.RawString(prefix)
+		out.String(string(in.File))
+	}
+	{
+		const prefix string = ",\"Module\":"
+		out.RawString(prefix)
+		out.String(string(in.Module))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Callers\":"
+		out.RawString(prefix)
+		out.Int(int(in.Callers))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepsFunction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepsFunction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepsFunction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepsFunction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor77(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(in *jlexer.Lexer, out *DeclClause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Variant":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Variant).UnmarshalEasyJSON(in)
+			}
+		case "Args":
+			if in.IsNull() {
+				in.Skip()
+				out.Args = nil
+			} else {
+				in.Delim('[')
+				if out.Args == nil {
+					if !in.IsDelim(']') {
+						out.Args = make([]Assign, 0, 0)
+					} else {
+						out.Args = []Assign{}
+					}
+				} else {
+					out.Args = (out.Args)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v186 Assign
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v186).UnmarshalEasyJSON(in)
+					}
+					out.Args = append(out.Args, v186)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(out *jwriter.Writer, in DeclClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Variant\":"
+		out.RawString(prefix)
+		(in.Variant).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Args\":"
+		out.RawString(prefix)
+		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v187, v188 := range in.Args {
+				if v187 > 0 {
+					out.RawByte(',')
+				}
+				(v188).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeclClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeclClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeclClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeclClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor78(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(in *jlexer.Lexer, out *DblQuoted) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Dollar":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dollar = bool(in.Bool())
+			}
+		case "Parts":
+			if m, ok := out.Parts.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Parts.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Parts = in.Interface()
+			}
+		case "Left":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Left).UnmarshalEasyJSON(in)
+			}
+		case "Right":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Right).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(out *jwriter.Writer, in DblQuoted) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Dollar\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dollar))
+	}
+	{
+		const prefix string = ",\"Parts\":"
+		out.RawString(prefix)
+		if m, ok := in.Parts.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Parts.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Parts))
+		}
+	}
+	{
+		const prefix string = ",\"Left\":"
+		out.RawString(prefix)
+		(in.Left).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Right\":"
+		out.RawString(prefix)
+		(in.Right).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DblQuoted) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DblQuoted) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DblQuoted) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DblQuoted) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor79(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(in *jlexer.Lexer, out *CoprocClause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(Word)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Name).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Stmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Stmt).UnmarshalEasyJSON(in)
+			}
+		case "Coproc":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Coproc).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(out *jwriter.Writer, in CoprocClause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			(*in.Name).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Stmt\":"
+		out.RawString(prefix)
+		(in.Stmt).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Coproc\":"
+		out.RawString(prefix)
+		(in.Coproc).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CoprocClause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CoprocClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CoprocClause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CoprocClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor80(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(in *jlexer.Lexer, out *Comment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Hash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Hash).UnmarshalEasyJSON(in)
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(out *jwriter.Writer, in Comment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Hash\":"
+		out.RawString(prefix)
+		(in.Hash).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Comment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Comment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor81(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(in *jlexer.Lexer, out *CommandSignature) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Flags":
 			if in.IsNull() {
 				in.Skip()
-				out.Then = nil
+				out.Flags = nil
 			} else {
 				in.Delim('[')
-				if out.Then == nil {
+				if out.Flags == nil {
 					if !in.IsDelim(']') {
-						out.Then = make([]Stmt, 0, 0)
+						out.Flags = make([]CommandFlag, 0, 1)
 					} else {
-						out.Then = []Stmt{}
+						out.Flags = []CommandFlag{}
 					}
 				} else {
-					out.Then = (out.Then)[:0]
+					out.Flags = (out.Flags)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v20 Stmt
+					var v189 CommandFlag
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v20).UnmarshalEasyJSON(in)
+						(v189).UnmarshalEasyJSON(in)
 					}
-					out.Then = append(out.Then, v20)
+					out.Flags = append(out.Flags, v189)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Else":
-			if in.IsNull() {
-				in.Skip()
-				out.Else = nil
-			} else {
-				if out.Else == nil {
-					out.Else = new(IfClause)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Else).UnmarshalEasyJSON(in)
-				}
-			}
-		case "ThenPos":
+		case "AnyFlags":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.ThenPos).UnmarshalEasyJSON(in)
+				out.AnyFlags = bool(in.Bool())
 			}
-		case "FiPos":
+		case "MinArgs":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.FiPos).UnmarshalEasyJSON(in)
+				out.MinArgs = int(in.Int())
 			}
-		case "CondLast":
+		case "MaxArgs":
 			if in.IsNull() {
 				in.Skip()
-				out.CondLast = nil
+				out.MaxArgs = nil
 			} else {
-				in.Delim('[')
-				if out.CondLast == nil {
-					if !in.IsDelim(']') {
-						out.CondLast = make([]Comment, 0, 0)
-					} else {
-						out.CondLast = []Comment{}
-					}
-				} else {
-					out.CondLast = (out.CondLast)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v21 Comment
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v21).UnmarshalEasyJSON(in)
-					}
-					out.CondLast = append(out.CondLast, v21)
-					in.WantComma()
+				if out.MaxArgs == nil {
+					out.MaxArgs = new(int)
 				}
-				in.Delim(']')
-			}
-		case "ThenLast":
-			if in.IsNull() {
-				in.Skip()
-				out.ThenLast = nil
-			} else {
-				in.Delim('[')
-				if out.ThenLast == nil {
-					if !in.IsDelim(']') {
-						out.ThenLast = make([]Comment, 0, 0)
-					} else {
-						out.ThenLast = []Comment{}
-					}
+				if in.IsNull() {
+					in.Skip()
 				} else {
-					out.ThenLast = (out.ThenLast)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v22 Comment
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v22).UnmarshalEasyJSON(in)
-					}
-					out.ThenLast = append(out.ThenLast, v22)
-					in.WantComma()
+					*out.MaxArgs = int(in.Int())
 				}
-				in.Delim(']')
 			}
-		case "Last":
+		case "Args":
 			if in.IsNull() {
 				in.Skip()
-				out.Last = nil
+				out.Args = nil
 			} else {
 				in.Delim('[')
-				if out.Last == nil {
+				if out.Args == nil {
 					if !in.IsDelim(']') {
-						out.Last = make([]Comment, 0, 0)
+						out.Args = make([]string, 0, 4)
 					} else {
-						out.Last = []Comment{}
+						out.Args = []string{}
 					}
 				} else {
-					out.Last = (out.Last)[:0]
+					out.Args = (out.Args)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v23 Comment
+					var v190 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v23).UnmarshalEasyJSON(in)
+						v190 = string(in.String())
 					}
-					out.Last = append(out.Last, v23)
+					out.Args = append(out.Args, v190)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
+		case "Rest":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.Rest = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -3046,151 +12697,355 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(out *jwriter.Writer, in IfClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(out *jwriter.Writer, in CommandSignature) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Cond\":"
+		const prefix string = ",\"Flags\":"
 		out.RawString(prefix)
-		if in.Cond == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Flags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v24, v25 := range in.Cond {
-				if v24 > 0 {
+			for v191, v192 := range in.Flags {
+				if v191 > 0 {
 					out.RawByte(',')
 				}
-				(v25).MarshalEasyJSON(out)
+				(v192).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Then\":"
+		const prefix string = ",\"AnyFlags\":"
 		out.RawString(prefix)
-		if in.Then == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Bool(bool(in.AnyFlags))
+	}
+	{
+		const prefix string = ",\"MinArgs\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinArgs))
+	}
+	{
+		const prefix string = ",\"MaxArgs\":"
+		out.RawString(prefix)
+		if in.MaxArgs == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.MaxArgs))
+		}
+	}
+	{
+		const prefix string = ",\"Args\":"
+		out.RawString(prefix)
+		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v26, v27 := range in.Then {
-				if v26 > 0 {
+			for v193, v194 := range in.Args {
+				if v193 > 0 {
 					out.RawByte(',')
 				}
-				(v27).MarshalEasyJSON(out)
+				out.String(string(v194))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Else\":"
+		const prefix string = ",\"Rest\":"
 		out.RawString(prefix)
-		if in.Else == nil {
-			out.RawString("null")
-		} else {
-			(*in.Else).MarshalEasyJSON(out)
+		out.String(string(in.Rest))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommandSignature) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommandSignature) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommandSignature) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommandSignature) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor82(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(in *jlexer.Lexer, out *CommandRegistry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"ThenPos\":"
-		out.RawString(prefix)
-		(in.ThenPos).MarshalEasyJSON(out)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]CommandSignature, 0, 0)
+					} else {
+						out.Commands = []CommandSignature{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v195 CommandSignature
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v195).UnmarshalEasyJSON(in)
+					}
+					out.Commands = append(out.Commands, v195)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Modules":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Modules = make(map[string][]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v196 []string
+					if in.IsNull() {
+						in.Skip()
+						v196 = nil
+					} else {
+						in.Delim('[')
+						if v196 == nil {
+							if !in.IsDelim(']') {
+								v196 = make([]string, 0, 4)
+							} else {
+								v196 = []string{}
+							}
+						} else {
+							v196 = (v196)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v197 string
+							if in.IsNull() {
+								in.Skip()
+							} else {
+								v197 = string(in.String())
+							}
+							v196 = append(v196, v197)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Modules)[key] = v196
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"FiPos\":"
-		out.RawString(prefix)
-		(in.FiPos).MarshalEasyJSON(out)
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(out *jwriter.Writer, in CommandRegistry) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"CondLast\":"
-		out.RawString(prefix)
-		if in.CondLast == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"Commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v28, v29 := range in.CondLast {
-				if v28 > 0 {
+			for v198, v199 := range in.Commands {
+				if v198 > 0 {
 					out.RawByte(',')
 				}
-				(v29).MarshalEasyJSON(out)
+				(v199).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"ThenLast\":"
+		const prefix string = ",\"Modules\":"
 		out.RawString(prefix)
-		if in.ThenLast == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		if in.Modules == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawByte('[')
-			for v30, v31 := range in.ThenLast {
-				if v30 > 0 {
+			out.RawByte('{')
+			v200First := true
+			for v200Name, v200Value := range in.Modules {
+				if v200First {
+					v200First = false
+				} else {
 					out.RawByte(',')
 				}
-				(v31).MarshalEasyJSON(out)
+				out.String(string(v200Name))
+				out.RawByte(':')
+				if v200Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v201, v202 := range v200Value {
+						if v201 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v202))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommandRegistry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommandRegistry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommandRegistry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommandRegistry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor83(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(in *jlexer.Lexer, out *CommandFlag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Arg":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Arg = bool(in.Bool())
+			}
+		case "ArgKind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ArgKind = string(in.String())
 			}
-			out.RawByte(']')
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(out *jwriter.Writer, in CommandFlag) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"Last\":"
-		out.RawString(prefix)
-		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.Last {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				(v33).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Arg\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		out.Bool(bool(in.Arg))
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"ArgKind\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		out.String(string(in.ArgKind))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IfClause) MarshalJSON() ([]byte, error) {
+func (v CommandFlag) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IfClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(w, v)
+func (v CommandFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IfClause) UnmarshalJSON(data []byte) error {
+func (v *CommandFlag) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IfClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor21(l, v)
+func (v *CommandFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor84(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(in *jlexer.Lexer, out *FuncDecl) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(in *jlexer.Lexer, out *CmdSubst) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3210,29 +13065,68 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "RsrvWord":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.RsrvWord = bool(in.Bool())
+				out.ID = int(in.Int())
 			}
-		case "Name":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Name).UnmarshalEasyJSON(in)
+				out.ParentID = int(in.Int())
 			}
-		case "Body":
+		case "TempFile":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Body).UnmarshalEasyJSON(in)
+				out.TempFile = bool(in.Bool())
 			}
-		case "Position":
+		case "ReplyVar":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Position).UnmarshalEasyJSON(in)
+				out.ReplyVar = bool(in.Bool())
+			}
+		case "Stmts":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmts = nil
+			} else {
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]Stmt, 0, 0)
+					} else {
+						out.Stmts = []Stmt{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v203 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v203).UnmarshalEasyJSON(in)
+					}
+					out.Stmts = append(out.Stmts, v203)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Left":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Left).UnmarshalEasyJSON(in)
+			}
+		case "Right":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Right).UnmarshalEasyJSON(in)
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -3256,7 +13150,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(out *jwriter.Writer, in FuncDecl) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(out *jwriter.Writer, in CmdSubst) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3266,24 +13160,50 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"RsrvWord\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.RsrvWord))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Name\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		(in.Name).MarshalEasyJSON(out)
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"Body\":"
+		const prefix string = ",\"TempFile\":"
 		out.RawString(prefix)
-		(in.Body).MarshalEasyJSON(out)
+		out.Bool(bool(in.TempFile))
 	}
 	{
-		const prefix string = ",\"Position\":"
+		const prefix string = ",\"ReplyVar\":"
 		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
+		out.Bool(bool(in.ReplyVar))
+	}
+	{
+		const prefix string = ",\"Stmts\":"
+		out.RawString(prefix)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v204, v205 := range in.Stmts {
+				if v204 > 0 {
+					out.RawByte(',')
+				}
+				(v205).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Left\":"
+		out.RawString(prefix)
+		(in.Left).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Right\":"
+		out.RawString(prefix)
+		(in.Right).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -3299,29 +13219,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FuncDecl) MarshalJSON() ([]byte, error) {
+func (v CmdSubst) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FuncDecl) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(w, v)
+func (v CmdSubst) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FuncDecl) UnmarshalJSON(data []byte) error {
+func (v *CmdSubst) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FuncDecl) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor22(l, v)
+func (v *CmdSubst) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor85(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(in *jlexer.Lexer, out *ForClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(in *jlexer.Lexer, out *CaseItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3341,64 +13261,110 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Select":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Select = bool(in.Bool())
+				out.ID = int(in.Int())
 			}
-		case "Loop":
-			if m, ok := out.Loop.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Loop.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
 			} else {
-				out.Loop = in.Interface()
+				out.ParentID = int(in.Int())
 			}
-		case "Do":
+		case "Op":
 			if in.IsNull() {
 				in.Skip()
-				out.Do = nil
+			} else {
+				out.Op = string(in.String())
+			}
+		case "Patterns":
+			if in.IsNull() {
+				in.Skip()
+				out.Patterns = nil
 			} else {
 				in.Delim('[')
-				if out.Do == nil {
+				if out.Patterns == nil {
 					if !in.IsDelim(']') {
-						out.Do = make([]Stmt, 0, 0)
+						out.Patterns = make([]Word, 0, 0)
 					} else {
-						out.Do = []Stmt{}
+						out.Patterns = []Word{}
 					}
 				} else {
-					out.Do = (out.Do)[:0]
+					out.Patterns = (out.Patterns)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 Stmt
+					var v206 Word
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v34).UnmarshalEasyJSON(in)
+						(v206).UnmarshalEasyJSON(in)
 					}
-					out.Do = append(out.Do, v34)
+					out.Patterns = append(out.Patterns, v206)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "ForPos":
+		case "Stmts":
 			if in.IsNull() {
 				in.Skip()
+				out.Stmts = nil
 			} else {
-				(out.ForPos).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]Stmt, 0, 0)
+					} else {
+						out.Stmts = []Stmt{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v207 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v207).UnmarshalEasyJSON(in)
+					}
+					out.Stmts = append(out.Stmts, v207)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "DoPos":
+		case "OpPos":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.DoPos).UnmarshalEasyJSON(in)
+				(out.OpPos).UnmarshalEasyJSON(in)
 			}
-		case "DonePos":
+		case "Comments":
 			if in.IsNull() {
 				in.Skip()
+				out.Comments = nil
 			} else {
-				(out.DonePos).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]Comment, 0, 0)
+					} else {
+						out.Comments = []Comment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v208 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v208).UnmarshalEasyJSON(in)
+					}
+					out.Comments = append(out.Comments, v208)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -3422,7 +13388,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(out *jwriter.Writer, in ForClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(out *jwriter.Writer, in CaseItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3432,51 +13398,72 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Select\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Select))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Loop\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if m, ok := in.Loop.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Loop.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Loop))
-		}
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"Do\":"
+		const prefix string = ",\"Op\":"
 		out.RawString(prefix)
-		if in.Do == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"Patterns\":"
+		out.RawString(prefix)
+		if in.Patterns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Do {
-				if v35 > 0 {
+			for v209, v210 := range in.Patterns {
+				if v209 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				(v210).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"ForPos\":"
+		const prefix string = ",\"Stmts\":"
 		out.RawString(prefix)
-		(in.ForPos).MarshalEasyJSON(out)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v211, v212 := range in.Stmts {
+				if v211 > 0 {
+					out.RawByte(',')
+				}
+				(v212).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"DoPos\":"
+		const prefix string = ",\"OpPos\":"
 		out.RawString(prefix)
-		(in.DoPos).MarshalEasyJSON(out)
+		(in.OpPos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"DonePos\":"
+		const prefix string = ",\"Comments\":"
 		out.RawString(prefix)
-		(in.DonePos).MarshalEasyJSON(out)
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v213, v214 := range in.Comments {
+				if v213 > 0 {
+					out.RawByte(',')
+				}
+				(v214).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -3492,29 +13479,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ForClause) MarshalJSON() ([]byte, error) {
+func (v CaseItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ForClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(w, v)
+func (v CaseItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ForClause) UnmarshalJSON(data []byte) error {
+func (v *CaseItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ForClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor23(l, v)
+func (v *CaseItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor86(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(in *jlexer.Lexer, out *File) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(in *jlexer.Lexer, out *CaseClause) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3534,39 +13521,63 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Name":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Name = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "Stmts":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
-				out.Stmts = nil
+			} else {
+				out.ParentID = int(in.Int())
+			}
+		case "Word":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Word).UnmarshalEasyJSON(in)
+			}
+		case "Items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
 			} else {
 				in.Delim('[')
-				if out.Stmts == nil {
+				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Stmts = make([]Stmt, 0, 0)
+						out.Items = make([]CaseItem, 0, 0)
 					} else {
-						out.Stmts = []Stmt{}
+						out.Items = []CaseItem{}
 					}
 				} else {
-					out.Stmts = (out.Stmts)[:0]
+					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v37 Stmt
+					var v215 CaseItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v37).UnmarshalEasyJSON(in)
+						(v215).UnmarshalEasyJSON(in)
 					}
-					out.Stmts = append(out.Stmts, v37)
+					out.Items = append(out.Items, v215)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "Case":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Case).UnmarshalEasyJSON(in)
+			}
+		case "Esac":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Esac).UnmarshalEasyJSON(in)
+			}
 		case "Last":
 			if in.IsNull() {
 				in.Skip()
@@ -3583,13 +13594,13 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(in
 					out.Last = (out.Last)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v38 Comment
+					var v216 Comment
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v38).UnmarshalEasyJSON(in)
+						(v216).UnmarshalEasyJSON(in)
 					}
-					out.Last = append(out.Last, v38)
+					out.Last = append(out.Last, v216)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3616,7 +13627,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(out *jwriter.Writer, in File) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(out *jwriter.Writer, in CaseClause) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3626,26 +13637,46 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Name\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Stmts\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Word\":"
+		out.RawString(prefix)
+		(in.Word).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v39, v40 := range in.Stmts {
-				if v39 > 0 {
+			for v217, v218 := range in.Items {
+				if v217 > 0 {
 					out.RawByte(',')
 				}
-				(v40).MarshalEasyJSON(out)
+				(v218).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"Case\":"
+		out.RawString(prefix)
+		(in.Case).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Esac\":"
+		out.RawString(prefix)
+		(in.Esac).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"Last\":"
 		out.RawString(prefix)
@@ -3653,11 +13684,11 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v41, v42 := range in.Last {
-				if v41 > 0 {
+			for v219, v220 := range in.Last {
+				if v219 > 0 {
 					out.RawByte(',')
 				}
-				(v42).MarshalEasyJSON(out)
+				(v220).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -3676,116 +13707,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v File) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v File) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *File) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *File) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor24(l, v)
-}
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(in *jlexer.Lexer, out *Expansion) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Op":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Op = string(in.String())
-			}
-		case "Word":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Word).UnmarshalEasyJSON(in)
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(out *jwriter.Writer, in Expansion) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"Type\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Op\":"
-		out.RawString(prefix)
-		out.String(string(in.Op))
-	}
-	{
-		const prefix string = ",\"Word\":"
-		out.RawString(prefix)
-		(in.Word).MarshalEasyJSON(out)
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Expansion) MarshalJSON() ([]byte, error) {
+func (v CaseClause) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Expansion) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(w, v)
+func (v CaseClause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Expansion) UnmarshalJSON(data []byte) error {
+func (v *CaseClause) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Expansion) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor25(l, v)
+func (v *CaseClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor87(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(in *jlexer.Lexer, out *DeclClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(in *jlexer.Lexer, out *Capabilities) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3799,56 +13743,59 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Variant":
+		case "Nodes":
 			if in.IsNull() {
 				in.Skip()
-			} else {
-				(out.Variant).UnmarshalEasyJSON(in)
-			}
-		case "Args":
-			if in.IsNull() {
-				in.Skip()
-				out.Args = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Args == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Args = make([]Assign, 0, 0)
+						out.Nodes = make([]string, 0, 4)
 					} else {
-						out.Args = []Assign{}
+						out.Nodes = []string{}
 					}
 				} else {
-					out.Args = (out.Args)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v43 Assign
+					var v221 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v43).UnmarshalEasyJSON(in)
+						v221 = string(in.String())
 					}
-					out.Args = append(out.Args, v43)
+					out.Nodes = append(out.Nodes, v221)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
+		case "Operators":
 			if in.IsNull() {
 				in.Skip()
+				out.Operators = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Operators == nil {
+					if !in.IsDelim(']') {
+						out.Operators = make([]string, 0, 4)
+					} else {
+						out.Operators = []string{}
+					}
+				} else {
+					out.Operators = (out.Operators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v222 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v222 = string(in.String())
+					}
+					out.Operators = append(out.Operators, v222)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -3860,73 +13807,69 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(out *jwriter.Writer, in DeclClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(out *jwriter.Writer, in Capabilities) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"Nodes\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Variant\":"
-		out.RawString(prefix)
-		(in.Variant).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Args\":"
-		out.RawString(prefix)
-		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v44, v45 := range in.Args {
-				if v44 > 0 {
+			for v223, v224 := range in.Nodes {
+				if v223 > 0 {
 					out.RawByte(',')
 				}
-				(v45).MarshalEasyJSON(out)
+				out.String(string(v224))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Operators\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.Operators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v225, v226 := range in.Operators {
+				if v225 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v226))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeclClause) MarshalJSON() ([]byte, error) {
+func (v Capabilities) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeclClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(w, v)
+func (v Capabilities) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeclClause) UnmarshalJSON(data []byte) error {
+func (v *Capabilities) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeclClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor26(l, v)
+func (v *Capabilities) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor88(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(in *jlexer.Lexer, out *DblQuoted) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(in *jlexer.Lexer, out *CallExpr) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3946,31 +13889,71 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Dollar":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Dollar = bool(in.Bool())
+				out.ID = int(in.Int())
 			}
-		case "Parts":
-			if m, ok := out.Parts.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Parts.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
 			} else {
-				out.Parts = in.Interface()
+				out.ParentID = int(in.Int())
 			}
-		case "Left":
+		case "Assigns":
 			if in.IsNull() {
 				in.Skip()
+				out.Assigns = nil
 			} else {
-				(out.Left).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Assigns == nil {
+					if !in.IsDelim(']') {
+						out.Assigns = make([]Assign, 0, 0)
+					} else {
+						out.Assigns = []Assign{}
+					}
+				} else {
+					out.Assigns = (out.Assigns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v227 Assign
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v227).UnmarshalEasyJSON(in)
+					}
+					out.Assigns = append(out.Assigns, v227)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "Right":
+		case "Args":
 			if in.IsNull() {
 				in.Skip()
+				out.Args = nil
 			} else {
-				(out.Right).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Args == nil {
+					if !in.IsDelim(']') {
+						out.Args = make([]Word, 0, 0)
+					} else {
+						out.Args = []Word{}
+					}
+				} else {
+					out.Args = (out.Args)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v228 Word
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v228).UnmarshalEasyJSON(in)
+					}
+					out.Args = append(out.Args, v228)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -3994,7 +13977,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(out *jwriter.Writer, in DblQuoted) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(out *jwriter.Writer, in CallExpr) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4004,30 +13987,46 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Dollar\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Dollar))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Parts\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if m, ok := in.Parts.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Parts.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Parts))
-		}
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"Left\":"
+		const prefix string = ",\"Assigns\":"
 		out.RawString(prefix)
-		(in.Left).MarshalEasyJSON(out)
+		if in.Assigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v229, v230 := range in.Assigns {
+				if v229 > 0 {
+					out.RawByte(',')
+				}
+				(v230).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"Right\":"
+		const prefix string = ",\"Args\":"
 		out.RawString(prefix)
-		(in.Right).MarshalEasyJSON(out)
+		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v231, v232 := range in.Args {
+				if v231 > 0 {
+					out.RawByte(',')
+				}
+				(v232).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -4043,29 +14042,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DblQuoted) MarshalJSON() ([]byte, error) {
+func (v CallExpr) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DblQuoted) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(w, v)
+func (v CallExpr) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DblQuoted) UnmarshalJSON(data []byte) error {
+func (v *CallExpr) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DblQuoted) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor27(l, v)
+func (v *CallExpr) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor89(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(in *jlexer.Lexer, out *CoprocClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(in *jlexer.Lexer, out *CStyleLoop) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4085,31 +14084,41 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Name":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
-				out.Name = nil
 			} else {
-				if out.Name == nil {
-					out.Name = new(Word)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Name).UnmarshalEasyJSON(in)
-				}
+				out.ID = int(in.Int())
 			}
-		case "Stmt":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Stmt).UnmarshalEasyJSON(in)
+				out.ParentID = int(in.Int())
 			}
-		case "Coproc":
-			if in.IsNull() {
-				in.Skip()
+		case "Init":
+			if m, ok := out.Init.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Init.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				(out.Coproc).UnmarshalEasyJSON(in)
+				out.Init = in.Interface()
+			}
+		case "Cond":
+			if m, ok := out.Cond.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Cond.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Cond = in.Interface()
+			}
+		case "Post":
+			if m, ok := out.Post.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Post.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Post = in.Interface()
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -4133,7 +14142,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(out *jwriter.Writer, in CoprocClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(out *jwriter.Writer, in CStyleLoop) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4143,23 +14152,47 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Name\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		if in.Name == nil {
-			out.RawString("null")
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"Init\":"
+		out.RawString(prefix)
+		if m, ok := in.Init.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Init.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
 		} else {
-			(*in.Name).MarshalEasyJSON(out)
+			out.Raw(json.Marshal(in.Init))
 		}
 	}
 	{
-		const prefix string = ",\"Stmt\":"
+		const prefix string = ",\"Cond\":"
 		out.RawString(prefix)
-		(in.Stmt).MarshalEasyJSON(out)
+		if m, ok := in.Cond.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Cond.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Cond))
+		}
 	}
 	{
-		const prefix string = ",\"Coproc\":"
+		const prefix string = ",\"Post\":"
 		out.RawString(prefix)
-		(in.Coproc).MarshalEasyJSON(out)
+		if m, ok := in.Post.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Post.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Post))
+		}
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -4175,29 +14208,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CoprocClause) MarshalJSON() ([]byte, error) {
+func (v CStyleLoop) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CoprocClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(w, v)
+func (v CStyleLoop) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CoprocClause) UnmarshalJSON(data []byte) error {
+func (v *CStyleLoop) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CoprocClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor28(l, v)
+func (v *CStyleLoop) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor90(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(in *jlexer.Lexer, out *Comment) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(in *jlexer.Lexer, out *BranchArm) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4211,29 +14244,78 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Text":
+		case "Label":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Text = string(in.String())
+				out.Label = string(in.String())
 			}
-		case "Hash":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Hash).UnmarshalEasyJSON(in)
+				out.ID = int(in.Int())
+			}
+		case "Stmts":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmts = nil
+			} else {
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]int, 0, 8)
+					} else {
+						out.Stmts = []int{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v233 int
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v233 = int(in.Int())
+					}
+					out.Stmts = append(out.Stmts, v233)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Implicit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Implicit = bool(in.Bool())
 			}
 		case "Pos":
 			if in.IsNull() {
 				in.Skip()
+				out.Pos = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				if out.Pos == nil {
+					out.Pos = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Pos).UnmarshalEasyJSON(in)
+				}
 			}
 		case "End":
 			if in.IsNull() {
 				in.Skip()
+				out.End = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				if out.End == nil {
+					out.End = new(Pos)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.End).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -4245,57 +14327,86 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(out *jwriter.Writer, in Comment) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(out *jwriter.Writer, in BranchArm) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Text\":"
+		const prefix string = ",\"Label\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Text))
+		out.String(string(in.Label))
 	}
 	{
-		const prefix string = ",\"Hash\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		(in.Hash).MarshalEasyJSON(out)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"Stmts\":"
+		out.RawString(prefix)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v234, v235 := range in.Stmts {
+				if v234 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v235))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Implicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Implicit))
 	}
 	{
 		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		if in.Pos == nil {
+			out.RawString("null")
+		} else {
+			(*in.Pos).MarshalEasyJSON(out)
+		}
 	}
 	{
 		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			(*in.End).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Comment) MarshalJSON() ([]byte, error) {
+func (v BranchArm) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(w, v)
+func (v BranchArm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Comment) UnmarshalJSON(data []byte) error {
+func (v *BranchArm) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(&r, v)
 	return r.Error()
 }
 
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor29(l, v)
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BranchArm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor91(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(in *jlexer.Lexer, out *CmdSubst) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(in *jlexer.Lexer, out *Branch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4309,75 +14420,63 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "TempFile":
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TempFile = bool(in.Bool())
+				out.Kind = string(in.String())
 			}
-		case "ReplyVar":
+		case "Function":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ReplyVar = bool(in.Bool())
+				out.Function = string(in.String())
 			}
-		case "Stmts":
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
 			if in.IsNull() {
 				in.Skip()
-				out.Stmts = nil
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Arms":
+			if in.IsNull() {
+				in.Skip()
+				out.Arms = nil
 			} else {
 				in.Delim('[')
-				if out.Stmts == nil {
+				if out.Arms == nil {
 					if !in.IsDelim(']') {
-						out.Stmts = make([]Stmt, 0, 0)
+						out.Arms = make([]BranchArm, 0, 0)
 					} else {
-						out.Stmts = []Stmt{}
+						out.Arms = []BranchArm{}
 					}
 				} else {
-					out.Stmts = (out.Stmts)[:0]
+					out.Arms = (out.Arms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v46 Stmt
+					var v236 BranchArm
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v46).UnmarshalEasyJSON(in)
+						(v236).UnmarshalEasyJSON(in)
 					}
-					out.Stmts = append(out.Stmts, v46)
+					out.Arms = append(out.Arms, v236)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Left":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Left).UnmarshalEasyJSON(in)
-			}
-		case "Right":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Right).UnmarshalEasyJSON(in)
-			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.End).UnmarshalEasyJSON(in)
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4388,88 +14487,78 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(out *jwriter.Writer, in CmdSubst) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(out *jwriter.Writer, in Branch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"TempFile\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.TempFile))
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"ReplyVar\":"
+		const prefix string = ",\"Function\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ReplyVar))
+		out.String(string(in.Function))
 	}
 	{
-		const prefix string = ",\"Stmts\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Arms\":"
+		out.RawString(prefix)
+		if in.Arms == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v47, v48 := range in.Stmts {
-				if v47 > 0 {
+			for v237, v238 := range in.Arms {
+				if v237 > 0 {
 					out.RawByte(',')
 				}
-				(v48).MarshalEasyJSON(out)
+				(v238).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"Left\":"
-		out.RawString(prefix)
-		(in.Left).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Right\":"
-		out.RawString(prefix)
-		(in.Right).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
-		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CmdSubst) MarshalJSON() ([]byte, error) {
+func (v Branch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CmdSubst) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(w, v)
+func (v Branch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CmdSubst) UnmarshalJSON(data []byte) error {
+func (v *Branch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CmdSubst) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor30(l, v)
+func (v *Branch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor92(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(in *jlexer.Lexer, out *CaseItem) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(in *jlexer.Lexer, out *Block) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4489,38 +14578,17 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Op":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Op = string(in.String())
+				out.ID = int(in.Int())
 			}
-		case "Patterns":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
-				out.Patterns = nil
 			} else {
-				in.Delim('[')
-				if out.Patterns == nil {
-					if !in.IsDelim(']') {
-						out.Patterns = make([]Word, 0, 0)
-					} else {
-						out.Patterns = []Word{}
-					}
-				} else {
-					out.Patterns = (out.Patterns)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v49 Word
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v49).UnmarshalEasyJSON(in)
-					}
-					out.Patterns = append(out.Patterns, v49)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.ParentID = int(in.Int())
 			}
 		case "Stmts":
 			if in.IsNull() {
@@ -4538,46 +14606,52 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(in
 					out.Stmts = (out.Stmts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v50 Stmt
+					var v239 Stmt
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v50).UnmarshalEasyJSON(in)
+						(v239).UnmarshalEasyJSON(in)
 					}
-					out.Stmts = append(out.Stmts, v50)
+					out.Stmts = append(out.Stmts, v239)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "OpPos":
+		case "Lbrace":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.OpPos).UnmarshalEasyJSON(in)
+				(out.Lbrace).UnmarshalEasyJSON(in)
 			}
-		case "Comments":
+		case "Rbrace":
 			if in.IsNull() {
 				in.Skip()
-				out.Comments = nil
+			} else {
+				(out.Rbrace).UnmarshalEasyJSON(in)
+			}
+		case "Last":
+			if in.IsNull() {
+				in.Skip()
+				out.Last = nil
 			} else {
 				in.Delim('[')
-				if out.Comments == nil {
+				if out.Last == nil {
 					if !in.IsDelim(']') {
-						out.Comments = make([]Comment, 0, 0)
+						out.Last = make([]Comment, 0, 0)
 					} else {
-						out.Comments = []Comment{}
+						out.Last = []Comment{}
 					}
 				} else {
-					out.Comments = (out.Comments)[:0]
+					out.Last = (out.Last)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v51 Comment
+					var v240 Comment
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v51).UnmarshalEasyJSON(in)
+						(v240).UnmarshalEasyJSON(in)
 					}
-					out.Comments = append(out.Comments, v51)
+					out.Last = append(out.Last, v240)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4604,7 +14678,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(out *jwriter.Writer, in CaseItem) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(out *jwriter.Writer, in Block) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4614,25 +14688,14 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Op\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		out.String(string(in.Op))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Patterns\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if in.Patterns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v52, v53 := range in.Patterns {
-				if v52 > 0 {
-					out.RawByte(',')
-				}
-				(v53).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.ParentID))
 	}
 	{
 		const prefix string = ",\"Stmts\":"
@@ -4641,32 +14704,37 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v54, v55 := range in.Stmts {
-				if v54 > 0 {
+			for v241, v242 := range in.Stmts {
+				if v241 > 0 {
 					out.RawByte(',')
 				}
-				(v55).MarshalEasyJSON(out)
+				(v242).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"OpPos\":"
+		const prefix string = ",\"Lbrace\":"
 		out.RawString(prefix)
-		(in.OpPos).MarshalEasyJSON(out)
+		(in.Lbrace).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Comments\":"
+		const prefix string = ",\"Rbrace\":"
 		out.RawString(prefix)
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.Rbrace).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Last\":"
+		out.RawString(prefix)
+		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.Comments {
-				if v56 > 0 {
+			for v243, v244 := range in.Last {
+				if v243 > 0 {
 					out.RawByte(',')
 				}
-				(v57).MarshalEasyJSON(out)
+				(v244).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4685,29 +14753,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CaseItem) MarshalJSON() ([]byte, error) {
+func (v Block) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CaseItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(w, v)
+func (v Block) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CaseItem) UnmarshalJSON(data []byte) error {
+func (v *Block) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CaseItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor31(l, v)
+func (v *Block) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor93(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(in *jlexer.Lexer, out *CaseClause) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(in *jlexer.Lexer, out *BinaryTest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4727,77 +14795,45 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Word":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Word).UnmarshalEasyJSON(in)
+				out.ID = int(in.Int())
 			}
-		case "Items":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
-				out.Items = nil
 			} else {
-				in.Delim('[')
-				if out.Items == nil {
-					if !in.IsDelim(']') {
-						out.Items = make([]CaseItem, 0, 0)
-					} else {
-						out.Items = []CaseItem{}
-					}
-				} else {
-					out.Items = (out.Items)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v58 CaseItem
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v58).UnmarshalEasyJSON(in)
-					}
-					out.Items = append(out.Items, v58)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.ParentID = int(in.Int())
 			}
-		case "Case":
+		case "Op":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Case).UnmarshalEasyJSON(in)
+				out.Op = string(in.String())
 			}
-		case "Esac":
-			if in.IsNull() {
-				in.Skip()
+		case "X":
+			if m, ok := out.X.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.X.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				(out.Esac).UnmarshalEasyJSON(in)
+				out.X = in.Interface()
 			}
-		case "Last":
+		case "Y":
+			if m, ok := out.Y.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Y.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Y = in.Interface()
+			}
+		case "OpPos":
 			if in.IsNull() {
 				in.Skip()
-				out.Last = nil
 			} else {
-				in.Delim('[')
-				if out.Last == nil {
-					if !in.IsDelim(']') {
-						out.Last = make([]Comment, 0, 0)
-					} else {
-						out.Last = []Comment{}
-					}
-				} else {
-					out.Last = (out.Last)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v59 Comment
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v59).UnmarshalEasyJSON(in)
-					}
-					out.Last = append(out.Last, v59)
-					in.WantComma()
-				}
-				in.Delim(']')
+				(out.OpPos).UnmarshalEasyJSON(in)
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -4821,7 +14857,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(out *jwriter.Writer, in CaseClause) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(out *jwriter.Writer, in BinaryTest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4831,52 +14867,47 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Word\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		(in.Word).MarshalEasyJSON(out)
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Items\":"
-		out.RawString(prefix)
-		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v60, v61 := range in.Items {
-				if v60 > 0 {
-					out.RawByte(',')
-				}
-				(v61).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
 	}
 	{
-		const prefix string = ",\"Case\":"
+		const prefix string = ",\"Op\":"
 		out.RawString(prefix)
-		(in.Case).MarshalEasyJSON(out)
+		out.String(string(in.Op))
 	}
 	{
-		const prefix string = ",\"Esac\":"
+		const prefix string = ",\"X\":"
 		out.RawString(prefix)
-		(in.Esac).MarshalEasyJSON(out)
+		if m, ok := in.X.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.X.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.X))
+		}
 	}
 	{
-		const prefix string = ",\"Last\":"
+		const prefix string = ",\"Y\":"
 		out.RawString(prefix)
-		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		if m, ok := in.Y.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Y.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
 		} else {
-			out.RawByte('[')
-			for v62, v63 := range in.Last {
-				if v62 > 0 {
-					out.RawByte(',')
-				}
-				(v63).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.Raw(json.Marshal(in.Y))
 		}
 	}
+	{
+		const prefix string = ",\"OpPos\":"
+		out.RawString(prefix)
+		(in.OpPos).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
@@ -4891,29 +14922,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CaseClause) MarshalJSON() ([]byte, error) {
+func (v BinaryTest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CaseClause) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(w, v)
+func (v BinaryTest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CaseClause) UnmarshalJSON(data []byte) error {
+func (v *BinaryTest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CaseClause) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor32(l, v)
+func (v *BinaryTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor94(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(in *jlexer.Lexer, out *CallExpr) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(in *jlexer.Lexer, out *BinaryCmd) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4933,59 +14964,41 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(in
 			} else {
 				out.Type = string(in.String())
 			}
-		case "Assigns":
+		case "ID":
 			if in.IsNull() {
 				in.Skip()
-				out.Assigns = nil
 			} else {
-				in.Delim('[')
-				if out.Assigns == nil {
-					if !in.IsDelim(']') {
-						out.Assigns = make([]Assign, 0, 0)
-					} else {
-						out.Assigns = []Assign{}
-					}
-				} else {
-					out.Assigns = (out.Assigns)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v64 Assign
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v64).UnmarshalEasyJSON(in)
-					}
-					out.Assigns = append(out.Assigns, v64)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.ID = int(in.Int())
 			}
-		case "Args":
+		case "ParentID":
 			if in.IsNull() {
 				in.Skip()
-				out.Args = nil
 			} else {
-				in.Delim('[')
-				if out.Args == nil {
-					if !in.IsDelim(']') {
-						out.Args = make([]Word, 0, 0)
-					} else {
-						out.Args = []Word{}
-					}
-				} else {
-					out.Args = (out.Args)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v65 Word
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v65).UnmarshalEasyJSON(in)
-					}
-					out.Args = append(out.Args, v65)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.ParentID = int(in.Int())
+			}
+		case "X":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.X).UnmarshalEasyJSON(in)
+			}
+		case "Y":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Y).UnmarshalEasyJSON(in)
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "OpPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.OpPos).UnmarshalEasyJSON(in)
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -5009,7 +15022,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(out *jwriter.Writer, in CallExpr) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(out *jwriter.Writer, in BinaryCmd) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5019,36 +15032,34 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(out
 		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"Assigns\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix)
-		if in.Assigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v66, v67 := range in.Assigns {
-				if v66 > 0 {
-					out.RawByte(',')
-				}
-				(v67).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"Args\":"
+		const prefix string = ",\"ParentID\":"
 		out.RawString(prefix)
-		if in.Args == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v68, v69 := range in.Args {
-				if v68 > 0 {
-					out.RawByte(',')
-				}
-				(v69).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		(in.X).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Y\":"
+		out.RawString(prefix)
+		(in.Y).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"OpPos\":"
+		out.RawString(prefix)
+		(in.OpPos).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -5064,29 +15075,29 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CallExpr) MarshalJSON() ([]byte, error) {
+func (v BinaryCmd) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CallExpr) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(w, v)
+func (v BinaryCmd) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CallExpr) UnmarshalJSON(data []byte) error {
+func (v *BinaryCmd) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CallExpr) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor33(l, v)
+func (v *BinaryCmd) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor95(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(in *jlexer.Lexer, out *CStyleLoop) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(in *jlexer.Lexer, out *BatchResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5100,47 +15111,38 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Init":
-			if m, ok := out.Init.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Init.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Init = in.Interface()
-			}
-		case "Cond":
-			if m, ok := out.Cond.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Cond.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Cond = in.Interface()
-			}
-		case "Post":
-			if m, ok := out.Post.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Post.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Post = in.Interface()
-			}
-		case "Pos":
+		case "results":
 			if in.IsNull() {
 				in.Skip()
+				out.Results = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]Result, 0, 0)
+					} else {
+						out.Results = []Result{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v245 Result
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v245).UnmarshalEasyJSON(in)
+					}
+					out.Results = append(out.Results, v245)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "End":
+		case "message":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				out.Message = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -5152,85 +15154,58 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(out *jwriter.Writer, in CStyleLoop) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(out *jwriter.Writer, in BatchResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Init\":"
-		out.RawString(prefix)
-		if m, ok := in.Init.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Init.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Init))
-		}
-	}
-	{
-		const prefix string = ",\"Cond\":"
-		out.RawString(prefix)
-		if m, ok := in.Cond.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Cond.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Cond))
-		}
-	}
-	{
-		const prefix string = ",\"Post\":"
-		out.RawString(prefix)
-		if m, ok := in.Post.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Post.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Post))
+			out.RawByte('[')
+			for v246, v247 := range in.Results {
+				if v246 > 0 {
+					out.RawByte(',')
+				}
+				(v247).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CStyleLoop) MarshalJSON() ([]byte, error) {
+func (v BatchResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CStyleLoop) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(w, v)
+func (v BatchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CStyleLoop) UnmarshalJSON(data []byte) error {
+func (v *BatchResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CStyleLoop) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor34(l, v)
+func (v *BatchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor96(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(in *jlexer.Lexer, out *Block) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(in *jlexer.Lexer, out *BatchOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5244,89 +15219,109 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
+		case "keepComments":
 			if in.IsNull() {
 				in.Skip()
+				out.KeepComments = nil
 			} else {
-				out.Type = string(in.String())
+				if out.KeepComments == nil {
+					out.KeepComments = new(bool)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.KeepComments = bool(in.Bool())
+				}
 			}
-		case "Stmts":
+		case "variant":
 			if in.IsNull() {
 				in.Skip()
-				out.Stmts = nil
+				out.Variant = nil
 			} else {
-				in.Delim('[')
-				if out.Stmts == nil {
-					if !in.IsDelim(']') {
-						out.Stmts = make([]Stmt, 0, 0)
-					} else {
-						out.Stmts = []Stmt{}
-					}
-				} else {
-					out.Stmts = (out.Stmts)[:0]
+				if out.Variant == nil {
+					out.Variant = new(int)
 				}
-				for !in.IsDelim(']') {
-					var v70 Stmt
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v70).UnmarshalEasyJSON(in)
-					}
-					out.Stmts = append(out.Stmts, v70)
-					in.WantComma()
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Variant = int(in.Int())
 				}
-				in.Delim(']')
 			}
-		case "Lbrace":
+		case "stopAt":
 			if in.IsNull() {
 				in.Skip()
+				out.StopAt = nil
 			} else {
-				(out.Lbrace).UnmarshalEasyJSON(in)
+				if out.StopAt == nil {
+					out.StopAt = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.StopAt = string(in.String())
+				}
 			}
-		case "Rbrace":
+		case "recoverErrors":
 			if in.IsNull() {
 				in.Skip()
+				out.RecoverErrors = nil
 			} else {
-				(out.Rbrace).UnmarshalEasyJSON(in)
+				if out.RecoverErrors == nil {
+					out.RecoverErrors = new(int)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.RecoverErrors = int(in.Int())
+				}
 			}
-		case "Last":
+		case "aliases":
 			if in.IsNull() {
 				in.Skip()
-				out.Last = nil
 			} else {
-				in.Delim('[')
-				if out.Last == nil {
-					if !in.IsDelim(']') {
-						out.Last = make([]Comment, 0, 0)
-					} else {
-						out.Last = []Comment{}
-					}
-				} else {
-					out.Last = (out.Last)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v71 Comment
+				in.Delim('{')
+				out.Aliases = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v248 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v71).UnmarshalEasyJSON(in)
+						v248 = string(in.String())
 					}
-					out.Last = append(out.Last, v71)
+					(out.Aliases)[key] = v248
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
-		case "Pos":
+		case "limits":
 			if in.IsNull() {
 				in.Skip()
+				out.Limits = nil
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				if out.Limits == nil {
+					out.Limits = new(ParseLimits)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Limits).UnmarshalEasyJSON(in)
+				}
 			}
-		case "End":
+		case "nodeTable":
 			if in.IsNull() {
 				in.Skip()
+				out.NodeTable = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				if out.NodeTable == nil {
+					out.NodeTable = new(bool)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.NodeTable = bool(in.Bool())
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -5338,94 +15333,112 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(out *jwriter.Writer, in Block) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(out *jwriter.Writer, in BatchOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"keepComments\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		if in.KeepComments == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.KeepComments))
+		}
 	}
 	{
-		const prefix string = ",\"Stmts\":"
+		const prefix string = ",\"variant\":"
 		out.RawString(prefix)
-		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Variant == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v72, v73 := range in.Stmts {
-				if v72 > 0 {
-					out.RawByte(',')
-				}
-				(v73).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.Int(int(*in.Variant))
 		}
 	}
 	{
-		const prefix string = ",\"Lbrace\":"
+		const prefix string = ",\"stopAt\":"
 		out.RawString(prefix)
-		(in.Lbrace).MarshalEasyJSON(out)
+		if in.StopAt == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.StopAt))
+		}
 	}
 	{
-		const prefix string = ",\"Rbrace\":"
+		const prefix string = ",\"recoverErrors\":"
 		out.RawString(prefix)
-		(in.Rbrace).MarshalEasyJSON(out)
+		if in.RecoverErrors == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.RecoverErrors))
+		}
 	}
 	{
-		const prefix string = ",\"Last\":"
+		const prefix string = ",\"aliases\":"
 		out.RawString(prefix)
-		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		if in.Aliases == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawByte('[')
-			for v74, v75 := range in.Last {
-				if v74 > 0 {
+			out.RawByte('{')
+			v249First := true
+			for v249Name, v249Value := range in.Aliases {
+				if v249First {
+					v249First = false
+				} else {
 					out.RawByte(',')
 				}
-				(v75).MarshalEasyJSON(out)
+				out.String(string(v249Name))
+				out.RawByte(':')
+				out.String(string(v249Value))
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"limits\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		if in.Limits == nil {
+			out.RawString("null")
+		} else {
+			(*in.Limits).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"nodeTable\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.NodeTable == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.NodeTable))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Block) MarshalJSON() ([]byte, error) {
+func (v BatchOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Block) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(w, v)
+func (v BatchOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Block) UnmarshalJSON(data []byte) error {
+func (v *BatchOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Block) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor35(l, v)
+func (v *BatchOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor97(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(in *jlexer.Lexer, out *BinaryTest) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(in *jlexer.Lexer, out *BatchItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5439,51 +15452,23 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(in
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "Op":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Op = string(in.String())
-			}
-		case "X":
-			if m, ok := out.X.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.X.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.X = in.Interface()
-			}
-		case "Y":
-			if m, ok := out.Y.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Y.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Y = in.Interface()
-			}
-		case "OpPos":
+		case "name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.OpPos).UnmarshalEasyJSON(in)
+				out.Name = string(in.String())
 			}
-		case "Pos":
+		case "text":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				out.Text = string(in.String())
 			}
-		case "End":
+		case "options":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				(out.Options).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -5495,138 +15480,97 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(out *jwriter.Writer, in BinaryTest) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(out *jwriter.Writer, in BatchItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"Op\":"
-		out.RawString(prefix)
-		out.String(string(in.Op))
-	}
-	{
-		const prefix string = ",\"X\":"
-		out.RawString(prefix)
-		if m, ok := in.X.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.X.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.X))
-		}
-	}
-	{
-		const prefix string = ",\"Y\":"
-		out.RawString(prefix)
-		if m, ok := in.Y.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Y.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Y))
-		}
-	}
-	{
-		const prefix string = ",\"OpPos\":"
-		out.RawString(prefix)
-		(in.OpPos).MarshalEasyJSON(out)
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		out.String(string(in.Text))
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"options\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		(in.Options).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BinaryTest) MarshalJSON() ([]byte, error) {
+func (v BatchItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BinaryTest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(w, v)
+func (v BatchItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BinaryTest) UnmarshalJSON(data []byte) error {
+func (v *BatchItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BinaryTest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor36(l, v)
+func (v *BatchItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor98(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(in *jlexer.Lexer, out *BinaryCmd) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(in *jlexer.Lexer, out *Batch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
 			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		switch key {
-		case "Type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "X":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.X).UnmarshalEasyJSON(in)
-			}
-		case "Y":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Y).UnmarshalEasyJSON(in)
-			}
-		case "Op":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Op = string(in.String())
-			}
-		case "OpPos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.OpPos).UnmarshalEasyJSON(in)
-			}
-		case "Pos":
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "defaults":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				(out.Defaults).UnmarshalEasyJSON(in)
 			}
-		case "End":
+		case "items":
 			if in.IsNull() {
 				in.Skip()
+				out.Items = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]BatchItem, 0, 0)
+					} else {
+						out.Items = []BatchItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v250 BatchItem
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v250).UnmarshalEasyJSON(in)
+					}
+					out.Items = append(out.Items, v250)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -5638,72 +15582,58 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(out *jwriter.Writer, in BinaryCmd) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(out *jwriter.Writer, in Batch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"defaults\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"X\":"
-		out.RawString(prefix)
-		(in.X).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Y\":"
-		out.RawString(prefix)
-		(in.Y).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Op\":"
-		out.RawString(prefix)
-		out.String(string(in.Op))
-	}
-	{
-		const prefix string = ",\"OpPos\":"
-		out.RawString(prefix)
-		(in.OpPos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		(in.Defaults).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"items\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v251, v252 := range in.Items {
+				if v251 > 0 {
+					out.RawByte(',')
+				}
+				(v252).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BinaryCmd) MarshalJSON() ([]byte, error) {
+func (v Batch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BinaryCmd) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(w, v)
+func (v Batch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BinaryCmd) UnmarshalJSON(data []byte) error {
+func (v *Batch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BinaryCmd) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor37(l, v)
+func (v *Batch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor99(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(in *jlexer.Lexer, out *Assign) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(in *jlexer.Lexer, out *Assign) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5723,6 +15653,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(in
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Append":
 			if in.IsNull() {
 				in.Skip()
@@ -5807,7 +15749,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(out *jwriter.Writer, in Assign) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(out *jwriter.Writer, in Assign) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5816,6 +15758,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Append\":"
 		out.RawString(prefix)
@@ -5880,27 +15832,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(out
 // MarshalJSON supports json.Marshaler interface
 func (v Assign) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Assign) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Assign) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Assign) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor38(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor100(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in *jlexer.Lexer, out *ArrayExpr) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(in *jlexer.Lexer, out *ArrayExpr) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5920,6 +15872,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Elems":
 			if in.IsNull() {
 				in.Skip()
@@ -5936,13 +15900,13 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in
 					out.Elems = (out.Elems)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v76 ArrayElem
+					var v253 ArrayElem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v76).UnmarshalEasyJSON(in)
+						(v253).UnmarshalEasyJSON(in)
 					}
-					out.Elems = append(out.Elems, v76)
+					out.Elems = append(out.Elems, v253)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5975,13 +15939,13 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in
 					out.Last = (out.Last)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v77 Comment
+					var v254 Comment
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v77).UnmarshalEasyJSON(in)
+						(v254).UnmarshalEasyJSON(in)
 					}
-					out.Last = append(out.Last, v77)
+					out.Last = append(out.Last, v254)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6008,7 +15972,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out *jwriter.Writer, in ArrayExpr) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(out *jwriter.Writer, in ArrayExpr) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6017,6 +15981,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Elems\":"
 		out.RawString(prefix)
@@ -6024,11 +15998,11 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v78, v79 := range in.Elems {
-				if v78 > 0 {
+			for v255, v256 := range in.Elems {
+				if v255 > 0 {
 					out.RawByte(',')
 				}
-				(v79).MarshalEasyJSON(out)
+				(v256).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6050,11 +16024,11 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v80, v81 := range in.Last {
-				if v80 > 0 {
+			for v257, v258 := range in.Last {
+				if v257 > 0 {
 					out.RawByte(',')
 				}
-				(v81).MarshalEasyJSON(out)
+				(v258).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6075,27 +16049,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(out
 // MarshalJSON supports json.Marshaler interface
 func (v ArrayExpr) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArrayExpr) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArrayExpr) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArrayExpr) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor39(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor101(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(in *jlexer.Lexer, out *ArrayElem) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(in *jlexer.Lexer, out *ArrayElem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6115,6 +16089,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(in
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Index":
 			if m, ok := out.Index.(easyjson.Unmarshaler); ok {
 				m.UnmarshalEasyJSON(in)
@@ -6145,13 +16131,13 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(in
 					out.Comments = (out.Comments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v82 Comment
+					var v259 Comment
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v82).UnmarshalEasyJSON(in)
+						(v259).UnmarshalEasyJSON(in)
 					}
-					out.Comments = append(out.Comments, v82)
+					out.Comments = append(out.Comments, v259)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6178,7 +16164,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(out *jwriter.Writer, in ArrayElem) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(out *jwriter.Writer, in ArrayElem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6187,6 +16173,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Index\":"
 		out.RawString(prefix)
@@ -6210,11 +16206,11 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v83, v84 := range in.Comments {
-				if v83 > 0 {
+			for v260, v261 := range in.Comments {
+				if v260 > 0 {
 					out.RawByte(',')
 				}
-				(v84).MarshalEasyJSON(out)
+				(v261).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6235,27 +16231,27 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(out
 // MarshalJSON supports json.Marshaler interface
 func (v ArrayElem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArrayElem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArrayElem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArrayElem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor40(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor102(l, v)
 }
-func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(in *jlexer.Lexer, out *ArithmCmd) {
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(in *jlexer.Lexer, out *ArithmCmd) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6275,6 +16271,18 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(in
 			} else {
 				out.Type = string(in.String())
 			}
+		case "ID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int(in.Int())
+			}
+		case "ParentID":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ParentID = int(in.Int())
+			}
 		case "Unsigned":
 			if in.IsNull() {
 				in.Skip()
@@ -6323,7 +16331,7 @@ func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(in
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(out *jwriter.Writer, in ArithmCmd) {
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(out *jwriter.Writer, in ArithmCmd) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6332,6 +16340,16 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(out
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"ParentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
 	{
 		const prefix string = ",\"Unsigned\":"
 		out.RawString(prefix)
@@ -6374,23 +16392,154 @@ func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(out
 // MarshalJSON supports json.Marshaler interface
 func (v ArithmCmd) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(&w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArithmCmd) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(w, v)
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArithmCmd) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(&r, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArithmCmd) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor41(l, v)
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor103(l, v)
+}
+func easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(in *jlexer.Lexer, out *AliasExpansion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = string(in.String())
+			}
+		case "Parent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Parent = int(in.Int())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "OrigPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.OrigPos).UnmarshalEasyJSON(in)
+			}
+		case "OrigEnd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.OrigEnd).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(out *jwriter.Writer, in AliasExpansion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"Parent\":"
+		out.RawString(prefix)
+		out.Int(int(in.Parent))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"OrigPos\":"
+		out.RawString(prefix)
+		(in.OrigPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"OrigEnd\":"
+		out.RawString(prefix)
+		(in.OrigEnd).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AliasExpansion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AliasExpansion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AliasExpansion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AliasExpansion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComRobMyersNpcCliVitePackagesCliProcessor104(l, v)
 }
@@ -0,0 +1,390 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `symbolizer` resolves names lexically, approximating bash's dynamic scoping:
+// a name inside a function refers to the innermost `local` declared before it,
+// else to the enclosing function's, else to the global variable.
+type symbolizer struct {
+	table SymbolTable
+	// current scope IDs, innermost last
+	scopes []int
+	stack  []syntax.Node
+	// scope ID to name to variable index, for `local` and `declare` inside functions
+	locals []map[string]int
+	// global name to variable index
+	globals map[string]int
+	// offset from which each variable is in scope e.g. after `local x=$x`
+	visibleFrom []uint
+	// arithmetic operands which are assigned but not read e.g. `x` in `(( x = 1 ))`
+	writeOnly map[syntax.Node]bool
+}
+
+// `Symbols` collects the functions, variables and calls of `file`, with their definition and use sites.
+func Symbols(file *syntax.File) SymbolTable {
+	s := &symbolizer{
+		table: SymbolTable{
+			Scopes:    []SymbolScope{{ID: 0, Parent: -1, Function: -1, Pos: mapPos(file.Pos()), End: mapPos(file.End())}},
+			Functions: []SymbolFunction{},
+			Variables: []SymbolVariable{},
+			Calls:     []SymbolCall{},
+		},
+		scopes:    []int{0},
+		locals:    []map[string]int{{}},
+		globals:   map[string]int{},
+		writeOnly: map[syntax.Node]bool{},
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			if _, ok := s.stack[len(s.stack)-1].(*syntax.FuncDecl); ok {
+				s.scopes = s.scopes[:len(s.scopes)-1]
+			}
+			s.stack = s.stack[:len(s.stack)-1]
+			return true
+		}
+		s.visit(node)
+		s.stack = append(s.stack, node)
+		return true
+	})
+
+	s.resolveCalls()
+	return s.table
+}
+
+func (s *symbolizer) scope() int {
+	return s.scopes[len(s.scopes)-1]
+}
+
+func (s *symbolizer) function() *SymbolFunction {
+	if fn := s.table.Scopes[s.scope()].Function; fn >= 0 {
+		return &s.table.Functions[fn]
+	}
+	return nil
+}
+
+func (s *symbolizer) visit(node syntax.Node) {
+	switch node := node.(type) {
+	case *syntax.FuncDecl:
+		scopeID := len(s.table.Scopes)
+		s.table.Functions = append(s.table.Functions, SymbolFunction{
+			Name:        node.Name.Value,
+			Pos:         mapPos(node.Name.Pos()),
+			End:         mapPos(node.Name.End()),
+			BodyPos:     mapPos(node.Body.Pos()),
+			BodyEnd:     mapPos(node.Body.End()),
+			Scope:       s.scope(),
+			BodyScope:   scopeID,
+			Positionals: []string{},
+		})
+		s.table.Scopes = append(s.table.Scopes, SymbolScope{
+			ID:       scopeID,
+			Parent:   s.scope(),
+			Function: len(s.table.Functions) - 1,
+			Pos:      mapPos(node.Body.Pos()),
+			End:      mapPos(node.Body.End()),
+		})
+		s.locals = append(s.locals, map[string]int{})
+		s.scopes = append(s.scopes, scopeID)
+
+	case *syntax.CallExpr:
+		for _, assign := range node.Assigns {
+			if assign.Name != nil {
+				s.define(assign.Name, "assign", assign.End(), false, assign)
+			}
+		}
+		if len(node.Args) == 0 {
+			break
+		}
+		name := node.Args[0].Lit()
+		if name != "" && !strings.ContainsAny(name, "/=") {
+			s.table.Calls = append(s.table.Calls, SymbolCall{
+				Name:     name,
+				Pos:      mapPos(node.Args[0].Pos()),
+				End:      mapPos(node.Args[0].End()),
+				Scope:    s.scope(),
+				Function: -1,
+			})
+		}
+		switch name {
+		case "read":
+			for _, word := range readTargets(node.Args[1:]) {
+				s.define(word.Parts[0].(*syntax.Lit), "read", word.End(), false, nil)
+			}
+		case "unset":
+			s.unset(node.Args[1:])
+		}
+
+	case *syntax.DeclClause:
+		s.declare(node)
+
+	case *syntax.ForClause:
+		if iter, ok := node.Loop.(*syntax.WordIter); ok {
+			kind := "for"
+			if node.Select {
+				kind = "select"
+			}
+			s.define(iter.Name, kind, iter.Name.End(), false, nil)
+		}
+
+	case *syntax.BinaryArithm:
+		if lit, target := arithmTarget(node.X); lit != nil && isArithmAssign(node.Op) {
+			s.define(lit, "arithm", node.X.End(), false, nil)
+			if node.Op == syntax.Assgn {
+				s.writeOnly[target] = true
+			}
+		}
+
+	case *syntax.UnaryArithm:
+		if lit, _ := arithmTarget(node.X); lit != nil && (node.Op == syntax.Inc || node.Op == syntax.Dec) {
+			s.define(lit, "arithm", node.X.End(), false, nil)
+		}
+
+	case *syntax.ParamExp:
+		if s.writeOnly[node] {
+			break
+		}
+		name := node.Param.Value
+		if isPositionalParam(name) {
+			if fn := s.function(); fn != nil && !slices.Contains(fn.Positionals, name) {
+				fn.Positionals = append(fn.Positionals, name)
+			}
+			break
+		}
+		if !syntax.ValidName(name) || node.Names != 0 {
+			// e.g. `$$` or `${!prefix*}`
+			break
+		}
+		kind := "param"
+		if node.Excl && node.Index == nil {
+			kind = "indirect"
+		}
+		s.reference(node.Param, kind)
+
+	case *syntax.Lit:
+		if len(s.stack) < 2 || s.writeOnly[s.stack[len(s.stack)-1]] || !syntax.ValidName(node.Value) {
+			break
+		}
+		if isArithmWord(s.stack[len(s.stack)-2], s.stack[len(s.stack)-1]) {
+			s.reference(node, "arithm")
+		}
+
+	case *syntax.UnaryTest:
+		if word, ok := node.X.(*syntax.Word); ok && node.Op == syntax.TsVarSet && syntax.ValidName(word.Lit()) {
+			s.reference(word.Parts[0].(*syntax.Lit), "test")
+		}
+	}
+}
+
+// `declare` handles `declare`, `local`, `export`, `readonly`, `typeset` and `nameref`.
+func (s *symbolizer) declare(decl *syntax.DeclClause) {
+	variant := decl.Variant.Value
+	// inside a function, all but `export` and `readonly` declare locals unless `-g`
+	local := variant != "export" && variant != "readonly"
+	var flags SymbolVariable
+	flags.Exported = variant == "export"
+	flags.ReadOnly = variant == "readonly"
+	if variant == "nameref" {
+		flags.Kind = "nameref"
+	}
+
+	for _, assign := range decl.Args {
+		if assign.Name == nil {
+			// e.g. `-g`, `-xr`, `+x`
+			opts := assign.Value.Lit()
+			if !strings.HasPrefix(opts, "-") {
+				continue
+			}
+			for _, opt := range opts[1:] {
+				switch opt {
+				case 'g':
+					local = false
+				case 'x':
+					flags.Exported = true
+				case 'r':
+					flags.ReadOnly = true
+				case 'i':
+					flags.Integer = true
+				case 'a':
+					flags.Kind = "indexed"
+				case 'A':
+					flags.Kind = "associative"
+				case 'n':
+					flags.Kind = "nameref"
+				case 'f', 'F':
+					// functions, not variables
+					return
+				}
+			}
+			continue
+		}
+
+		index := s.define(assign.Name, variant, assign.End(), local && s.function() != nil, assign)
+		vr := &s.table.Variables[index]
+		vr.Exported = vr.Exported || flags.Exported
+		vr.ReadOnly = vr.ReadOnly || flags.ReadOnly
+		vr.Integer = vr.Integer || flags.Integer
+		if flags.Kind != "" {
+			vr.Kind = flags.Kind
+		}
+	}
+}
+
+// `define` records a definition site, creating a variable in the current function if `local`.
+// The variable is only visible to references from offset `visibleFrom`, e.g. not to `$x` in `local x=$x`.
+func (s *symbolizer) define(name *syntax.Lit, kind string, visibleFrom syntax.Pos, local bool, assign *syntax.Assign) int {
+	index, ok := -1, false
+	if local {
+		index, ok = s.locals[s.scope()][name.Value]
+		if !ok {
+			index = s.newVariable(name.Value, s.scope())
+			s.locals[s.scope()][name.Value] = index
+			s.table.Variables[index].Local = true
+			s.visibleFrom[index] = visibleFrom.Offset()
+		}
+	} else {
+		index = s.resolve(name.Value, name.Pos().Offset())
+	}
+
+	vr := &s.table.Variables[index]
+	vr.Defs = append(vr.Defs, SymbolRef{
+		Kind:  kind,
+		Pos:   mapPos(name.Pos()),
+		End:   mapPos(name.End()),
+		Scope: s.scope(),
+	})
+	if assign != nil && assign.Array != nil && vr.Kind == "" {
+		vr.Kind = "indexed"
+	}
+	return index
+}
+
+func (s *symbolizer) reference(name *syntax.Lit, kind string) {
+	vr := &s.table.Variables[s.resolve(name.Value, name.Pos().Offset())]
+	vr.Refs = append(vr.Refs, SymbolRef{
+		Kind:  kind,
+		Pos:   mapPos(name.Pos()),
+		End:   mapPos(name.End()),
+		Scope: s.scope(),
+	})
+}
+
+// `resolve` finds the innermost variable visible at `offset`, else the global one, which is created if needed.
+func (s *symbolizer) resolve(name string, offset uint) int {
+	for i := len(s.scopes) - 1; i > 0; i-- {
+		if index, ok := s.locals[s.scopes[i]][name]; ok && s.visibleFrom[index] <= offset {
+			return index
+		}
+	}
+	if index, ok := s.globals[name]; ok {
+		return index
+	}
+	index := s.newVariable(name, 0)
+	s.globals[name] = index
+	return index
+}
+
+func (s *symbolizer) newVariable(name string, scope int) int {
+	s.table.Variables = append(s.table.Variables, SymbolVariable{
+		Name:  name,
+		Scope: scope,
+		Defs:  []SymbolRef{},
+		Refs:  []SymbolRef{},
+	})
+	s.visibleFrom = append(s.visibleFrom, 0)
+	return len(s.table.Variables) - 1
+}
+
+func (s *symbolizer) unset(args []*syntax.Word) {
+	for _, arg := range args {
+		switch lit := arg.Lit(); {
+		case lit == "-f":
+			return
+		case strings.HasPrefix(lit, "-"):
+		case syntax.ValidName(lit):
+			s.define(arg.Parts[0].(*syntax.Lit), "unset", arg.End(), false, nil)
+		}
+	}
+}
+
+// `resolveCalls` links each call to the last function of that name declared before it,
+// else the first declared after it, since functions can be called before they are defined.
+func (s *symbolizer) resolveCalls() {
+	for i := range s.table.Calls {
+		call := &s.table.Calls[i]
+		for j, fn := range s.table.Functions {
+			if fn.Name != call.Name {
+				continue
+			}
+			if call.Function == -1 || fn.Pos.Offset < call.Pos.Offset {
+				call.Function = j
+			}
+		}
+	}
+	s.table.Calls = slices.DeleteFunc(s.table.Calls, func(call SymbolCall) bool {
+		return call.Function == -1
+	})
+}
+
+// `readTargets` returns the variable operands of `read`, including that of `-a`.
+func readTargets(args []*syntax.Word) []*syntax.Word {
+	targets := []*syntax.Word{}
+	for i := 0; i < len(args); i++ {
+		lit := args[i].Lit()
+		if strings.HasPrefix(lit, "-") && len(lit) > 1 {
+			// options taking an argument, possibly attached e.g. `-p prompt` or `-aarr`
+			if j := strings.IndexAny(lit[1:], "adinNptu"); j >= 0 {
+				opt := lit[1+j]
+				if 1+j+1 == len(lit) {
+					i++
+				}
+				if opt == 'a' && i < len(args) && syntax.ValidName(args[i].Lit()) && 1+j+1 == len(lit) {
+					targets = append(targets, args[i])
+				}
+			}
+			continue
+		}
+		if syntax.ValidName(lit) {
+			targets = append(targets, args[i])
+		}
+	}
+	return targets
+}
+
+// `arithmTarget` returns the name assigned by an arithmetic operand e.g. `x` or `a[i]`,
+// along with the node which would otherwise be treated as a reference.
+func arithmTarget(x syntax.ArithmExpr) (*syntax.Lit, syntax.Node) {
+	word, ok := x.(*syntax.Word)
+	if !ok || len(word.Parts) != 1 {
+		return nil, nil
+	}
+	switch part := word.Parts[0].(type) {
+	case *syntax.Lit:
+		if syntax.ValidName(part.Value) {
+			return part, word
+		}
+	case *syntax.ParamExp:
+		if part.Short && part.Index != nil {
+			return part.Param, part
+		}
+	}
+	return nil, nil
+}
+
+func isArithmAssign(op syntax.BinAritOperator) bool {
+	return op == syntax.Assgn || op >= syntax.AddAssgn && op <= syntax.ShrAssgn
+}
+
+// `isPositionalParam` holds for e.g. `$1`, `$@`, `$*` and `$#`.
+func isPositionalParam(name string) bool {
+	switch name {
+	case "@", "*", "#":
+		return true
+	}
+	return name != "" && strings.Trim(name, "0123456789") == "" && name != "0"
+}
@@ -0,0 +1,277 @@
+package processor
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `validator` checks literal commands against a registry of signatures.
+type validator struct {
+	registry CommandRegistry
+	commands map[string]*CommandSignature
+	// functions defined by the script, including via `import`
+	functions map[string]bool
+	diags     []Diagnostic
+}
+
+// `Validate` checks every `CallExpr` with a literal command name for unknown commands,
+// unknown flags, missing flag arguments, arity and argument kinds.
+//
+// Arguments which may expand to any number of fields e.g. `$x` or `"$@"` disable arity checks,
+// and are not checked against their kind.
+func Validate(file *syntax.File, registry CommandRegistry) []Diagnostic {
+	v := &validator{
+		registry:  registry,
+		commands:  map[string]*CommandSignature{},
+		functions: map[string]bool{},
+		diags:     []Diagnostic{},
+	}
+	for i := range registry.Commands {
+		v.commands[registry.Commands[i].Name] = &registry.Commands[i]
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.FuncDecl:
+			v.functions[node.Name.Value] = true
+		case *syntax.CallExpr:
+			v.importFunctions(node)
+		}
+		return true
+	})
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if call, ok := node.(*syntax.CallExpr); ok && len(call.Args) > 0 {
+			v.call(call)
+		}
+		return true
+	})
+
+	slices.SortStableFunc(v.diags, func(a, b Diagnostic) int {
+		return int(a.Pos.Offset) - int(b.Pos.Offset)
+	})
+	return v.diags
+}
+
+func (v *validator) report(rule string, node syntax.Node, message string) {
+	severity := SeverityError
+	if rule == "unknown-command" {
+		severity = SeverityWarning
+	}
+	v.diags = append(v.diags, Diagnostic{
+		Rule:     rule,
+		Severity: severity,
+		Message:  message,
+		Pos:      mapPos(node.Pos()),
+		End:      mapPos(node.End()),
+	})
+}
+
+// `importFunctions` records shell functions induced by e.g. `import util` or `import fn1 fn2:alias from util`.
+func (v *validator) importFunctions(call *syntax.CallExpr) {
+	if len(call.Args) == 0 || call.Args[0].Lit() != "import" {
+		return
+	}
+	for _, ref := range moduleRefs(v.registry.Modules, call) {
+		if len(ref.fns) == 0 {
+			for _, fn := range v.registry.Modules[ref.module.Lit()] {
+				v.functions[fn] = true
+			}
+			continue
+		}
+		for _, word := range ref.fns {
+			fn, alias, ok := strings.Cut(word.Lit(), ":")
+			if ok {
+				fn = alias
+			}
+			v.functions[fn] = true
+		}
+	}
+}
+
+func (v *validator) call(call *syntax.CallExpr) {
+	name := call.Args[0].Lit()
+	if name == "" || strings.Contains(name, "/") || v.functions[name] {
+		return
+	}
+	sig, ok := v.commands[name]
+	if !ok {
+		v.report("unknown-command", call.Args[0], "Unknown command `"+name+"`")
+		return
+	}
+
+	operands := []*syntax.Word{}
+	countKnown := true
+	endOfFlags := false
+	args := call.Args[1:]
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		lit := arg.Lit()
+		if !endOfFlags && lit == "--" {
+			endOfFlags = true
+			continue
+		}
+		if endOfFlags || !isFlag(lit) || v.isNumericOperand(sig, len(operands), lit) {
+			if !isSingleField(arg) {
+				countKnown = false
+			}
+			operands = append(operands, arg)
+			continue
+		}
+
+		flag, value, hasValue := v.flag(sig, arg)
+		if flag == nil || !flag.Arg || hasValue {
+			if flag != nil && hasValue && flag.Arg {
+				v.checkKind(flag.ArgKind, arg, value)
+			}
+			continue
+		}
+		if i+1 == len(args) {
+			v.report("missing-flag-arg", arg, "Flag `"+flag.Name+"` of `"+name+"` expects an argument")
+			continue
+		}
+		i++
+		if isSingleField(args[i]) && args[i].Lit() != "" {
+			v.checkKind(flag.ArgKind, args[i], args[i].Lit())
+		}
+	}
+
+	if countKnown {
+		switch {
+		case len(operands) < sig.MinArgs:
+			v.report("too-few-args", call, "`"+name+"` expects at least "+strconv.Itoa(sig.MinArgs)+" argument(s)")
+		case sig.MaxArgs != nil && len(operands) > *sig.MaxArgs:
+			v.report("too-many-args", operands[*sig.MaxArgs], "`"+name+"` expects at most "+strconv.Itoa(*sig.MaxArgs)+" argument(s)")
+		}
+	}
+
+	for i, operand := range operands {
+		if !isSingleField(operand) {
+			// positions of subsequent operands are unknown
+			break
+		}
+		kind := sig.Rest
+		if i < len(sig.Args) {
+			kind = sig.Args[i]
+		}
+		if lit := operand.Lit(); lit != "" {
+			v.checkKind(kind, operand, lit)
+		}
+	}
+}
+
+func isFlag(lit string) bool {
+	return len(lit) > 1 && lit[0] == '-'
+}
+
+// `isNumericOperand` supports e.g. `sleep -1` when the operand is numeric.
+func (v *validator) isNumericOperand(sig *CommandSignature, index int, lit string) bool {
+	kind := sig.Rest
+	if index < len(sig.Args) {
+		kind = sig.Args[index]
+	}
+	_, err := strconv.ParseFloat(lit, 64)
+	return kind == "number" && err == nil
+}
+
+// `flag` matches e.g. `--force`, `--to=foo`, `-s` or combined short flags `-xv`,
+// reporting unknown flags. An attached value is returned for `--to=foo`.
+func (v *validator) flag(sig *CommandSignature, arg *syntax.Word) (*CommandFlag, string, bool) {
+	if sig.AnyFlags {
+		return nil, "", false
+	}
+	text := arg.Lit()
+	if text == "" {
+		// e.g. `-"$x"`
+		return nil, "", false
+	}
+	name, value, hasValue := strings.Cut(text, "=")
+	if f := sig.findFlag(name); f != nil {
+		return f, value, hasValue
+	}
+	if !strings.HasPrefix(text, "--") && !hasValue {
+		// combined short flags, where only the last may take an argument
+		var last *CommandFlag
+		for _, c := range text[1:] {
+			f := sig.findFlag("-" + string(c))
+			if f == nil {
+				v.report("unknown-flag", arg, "Unknown flag `-"+string(c)+"` of `"+sig.Name+"`")
+				return nil, "", false
+			}
+			last = f
+		}
+		return last, "", false
+	}
+	v.report("unknown-flag", arg, "Unknown flag `"+name+"` of `"+sig.Name+"`")
+	return nil, "", false
+}
+
+func (sig *CommandSignature) findFlag(name string) *CommandFlag {
+	for i := range sig.Flags {
+		if sig.Flags[i].Name == name {
+			return &sig.Flags[i]
+		}
+	}
+	return nil
+}
+
+// `checkKind` validates a literal argument e.g. a `pid` must be a non-negative integer.
+func (v *validator) checkKind(kind string, node syntax.Node, value string) {
+	message := ""
+	switch kind {
+	case "pid":
+		if n, err := strconv.Atoi(value); err != nil || n < 0 {
+			message = "Expected a process id, found `" + value + "`"
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			message = "Expected a number, found `" + value + "`"
+		}
+	case "name":
+		if !syntax.ValidName(value) {
+			message = "Expected a variable name, found `" + value + "`"
+		}
+	case "path":
+		if strings.ContainsRune(value, 0) {
+			message = "Expected a path"
+		}
+	case "module-key":
+		if _, ok := v.registry.Modules[value]; v.registry.Modules != nil && !ok {
+			message = "Unknown module `" + value + "`"
+		}
+	}
+	if message != "" {
+		v.report("bad-arg", node, message)
+	}
+}
+
+// `isSingleField` holds for words which expand to exactly one argument e.g. `foo`, `"$x"` or `a$(( 1 + 1 ))`.
+func isSingleField(word *syntax.Word) bool {
+	for _, part := range word.Parts {
+		switch part := part.(type) {
+		case *syntax.Lit:
+			if hasGlobMeta(part.Value, false) || strings.ContainsAny(part.Value, "{") {
+				return false
+			}
+		case *syntax.SglQuoted, *syntax.ArithmExp:
+		case *syntax.DblQuoted:
+			for _, part := range part.Parts {
+				if pe, ok := part.(*syntax.ParamExp); ok && (pe.Param.Value == "@" || isAllElems(pe) || pe.Names == syntax.NamesPrefixWords) {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// `isAllElems` holds for e.g. `${a[@]}`.
+func isAllElems(pe *syntax.ParamExp) bool {
+	word, ok := pe.Index.(*syntax.Word)
+	return ok && word.Lit() == "@"
+}
@@ -0,0 +1,41 @@
+package processor
+
+import (
+	"testing"
+)
+
+func TestValidateImports(t *testing.T) {
+	registry := CommandRegistry{
+		Commands: []CommandSignature{{Name: "import", AnyFlags: true}},
+		Modules:  map[string][]string{"util": {"fn1", "fn2"}},
+	}
+	tests := []struct {
+		name string
+		text string
+		// whether `fn2` is reported as unknown
+		unknown bool
+	}{
+		{"module", "import util; fn2", false},
+		{"functions", "import fn1 fn2 from util; fn2", false},
+		{"alias", "import fn1:fn2 from util; fn2", false},
+		{"alias first", "import fn2:x fn1:fn2 from util; fn2", false},
+		{"not from", "import fn1 fn2 into util; fn2", true},
+		{"unknown module", "import nope; fn2", true},
+		{"assignment", "x=1; fn2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.text, "", ParserOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			unknown := false
+			for _, diag := range Validate(file, registry) {
+				unknown = unknown || diag.Rule == "unknown-command" && diag.Message == "Unknown command `fn2`"
+			}
+			if unknown != tt.unknown {
+				t.Errorf("fn2 unknown = %v, want %v", unknown, tt.unknown)
+			}
+		})
+	}
+}
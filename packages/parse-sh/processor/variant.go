@@ -0,0 +1,71 @@
+package processor
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"mvdan.cc/sh/v3/fileutil"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// e.g. `# shell=sh` or shellcheck's `# shellcheck shell=bash`
+var shellDirective = regexp.MustCompile(`^[ \t]*#[ \t]*(?:shellcheck[ \t]+)?shell=([a-z]+)`)
+
+// `ResolveVariant` returns `variant` unless it is `syntax.LangAuto`, else detects the variant from:
+//   - a `# shell=…` directive before the first command e.g. `# shell=sh` or `# shellcheck shell=bash`
+//   - the shebang e.g. `#!/bin/sh` or `#!/usr/bin/env bash`
+//   - the extension of `filepath` i.e. `.bash`, `.mksh` or `.bats`, since `.sh` is often bash
+//
+// defaulting to `syntax.LangBash`. It also returns how the variant was chosen:
+// "option", "directive", "shebang", "extension" or "default".
+func ResolveVariant(text string, filepath string, variant syntax.LangVariant) (syntax.LangVariant, string) {
+	if variant != syntax.LangAuto {
+		return variant, "option"
+	}
+	if variant, ok := shellVariant(leadingDirective(text)); ok {
+		return variant, "directive"
+	}
+	if variant, ok := shellVariant(fileutil.Shebang([]byte(text))); ok {
+		return variant, "shebang"
+	}
+	switch path.Ext(filepath) {
+	case ".bash":
+		return syntax.LangBash, "extension"
+	case ".mksh", ".ksh":
+		return syntax.LangMirBSDKorn, "extension"
+	case ".bats":
+		return syntax.LangBats, "extension"
+	}
+	return syntax.LangBash, "default"
+}
+
+// `leadingDirective` returns the shell of the first directive among the comments
+// preceding the first command, as shellcheck does, so not e.g. within a heredoc.
+func leadingDirective(text string) string {
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if m := shellDirective.FindStringSubmatch(line); m != nil {
+			return m[1]
+		}
+		if line != "" && !strings.HasPrefix(line, "#") {
+			break
+		}
+	}
+	return ""
+}
+
+// `shellVariant` maps a shell name to a variant the parser supports e.g. not `zsh`.
+func shellVariant(shell string) (syntax.LangVariant, bool) {
+	switch shell {
+	case "ksh":
+		return syntax.LangMirBSDKorn, true
+	case "auto", "":
+		return 0, false
+	}
+	var variant syntax.LangVariant
+	if err := variant.Set(shell); err != nil {
+		return 0, false
+	}
+	return variant, true
+}
@@ -0,0 +1,37 @@
+package processor
+
+import (
+	"testing"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+func TestResolveVariant(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		filepath string
+		variant  syntax.LangVariant
+		want     syntax.LangVariant
+		source   string
+	}{
+		{"option", "#!/bin/sh\n", "", syntax.LangBash, syntax.LangBash, "option"},
+		{"shebang", "#!/bin/sh\necho\n", "", syntax.LangAuto, syntax.LangPOSIX, "shebang"},
+		{"env shebang", "#!/usr/bin/env mksh\n", "", syntax.LangAuto, syntax.LangMirBSDKorn, "shebang"},
+		{"directive", "#!/bin/bash\n# shellcheck shell=sh\necho\n", "", syntax.LangAuto, syntax.LangPOSIX, "directive"},
+		{"directive after blank line", "\n  # shell=ksh\necho\n", "", syntax.LangAuto, syntax.LangMirBSDKorn, "directive"},
+		{"directive in heredoc", "#!/bin/bash\ncat <<EOF\n# shell=sh\nEOF\n", "", syntax.LangAuto, syntax.LangBash, "shebang"},
+		{"directive after command", "echo\n# shell=sh\n", "", syntax.LangAuto, syntax.LangBash, "default"},
+		{"unsupported directive", "#!/bin/sh\n# shell=zsh\n", "", syntax.LangAuto, syntax.LangPOSIX, "shebang"},
+		{"extension", "echo\n", "x.bats", syntax.LangAuto, syntax.LangBats, "extension"},
+		{"sh extension", "echo\n", "x.sh", syntax.LangAuto, syntax.LangBash, "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variant, source := ResolveVariant(tt.text, tt.filepath, tt.variant)
+			if variant != tt.want || source != tt.source {
+				t.Errorf("got %v from %q, want %v from %q", variant, source, tt.want, tt.source)
+			}
+		})
+	}
+}
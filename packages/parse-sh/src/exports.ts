@@ -0,0 +1,997 @@
+import type { MvdanSh } from "./mvdan-sh.d";
+import { type AliasExpansion, LangVariant, type NodeInfo, type ShParserOptions } from "./mvdan-sh.model";
+import { LimitError, loadWasm, ParseError } from "./parse";
+
+const encoder = new TextEncoder();
+const decoder = new TextDecoder();
+
+/**
+ * Invoke a Go export returning null-terminated JSON.
+ * - each string argument is passed as a Go `[]byte` i.e. (pointer, length, capacity)
+ * - `host` provides synchronous callbacks, see `host.go`
+ */
+export async function callExport<T>(
+  name: string,
+  args: (string | number | boolean)[],
+  host: ParseShHost = {},
+): Promise<T> {
+  const { wasm } = await loadWasm();
+  return invokeExport<T>(wasm.exports as unknown as WasmExports, name, args, host);
+}
+
+/**
+ * Invoke a Go export of a given instance, e.g. one kept alive by `createRunner`.
+ */
+function invokeExport<T>(
+  exports: WasmExports,
+  name: string,
+  args: (string | number | boolean)[],
+  host: ParseShHost = {},
+): T {
+  const fn = exports[name];
+  if (typeof fn !== "function") {
+    // e.g. main.wasm predates the export, and needs `pnpm build:wasm`
+    throw new Error(`callExport: ${name}: unknown export, main.wasm may need rebuilding`);
+  }
+
+  const pointers = [] as number[];
+  const flatArgs = [] as (number | boolean)[];
+  for (const arg of args) {
+    if (typeof arg === "string") {
+      const bytes = encoder.encode(arg);
+      const pointer = exports.wasmAlloc(bytes.byteLength);
+      new Uint8Array(exports.memory.buffer).set(bytes, pointer);
+      pointers.push(pointer);
+      flatArgs.push(pointer, bytes.byteLength, bytes.byteLength);
+    } else {
+      flatArgs.push(arg);
+    }
+  }
+
+  (globalThis as { __parseShHost?: ParseShHost }).__parseShHost = host;
+  try {
+    const resultPointer = fn(...flatArgs);
+    if (resultPointer === 0) {
+      throw new Error(`callExport: ${name}: resultPointer is 0`);
+    }
+    const resultBuffer = new Uint8Array(exports.memory.buffer).subarray(resultPointer);
+    const end = resultBuffer.indexOf(0);
+    return JSON.parse(decoder.decode(resultBuffer.subarray(0, end))) as T;
+  } finally {
+    delete (globalThis as { __parseShHost?: ParseShHost }).__parseShHost;
+    pointers.forEach((pointer) => exports.wasmFree(pointer));
+  }
+}
+
+type WasmExports = {
+  memory: WebAssembly.Memory;
+  wasmAlloc: (size: number) => number;
+  wasmFree: (pointer: number) => void;
+} & Record<string, (...args: (number | boolean)[]) => number>;
+
+/**
+ * Callbacks invoked synchronously by Go exports.
+ * Any callback may return `{ error }` to fail the current operation.
+ */
+export interface ParseShHost {
+  /** `undefined` means unset */
+  getVar?(name: string): undefined | string | HostVariable;
+  /** `variable` is `undefined` for unset */
+  setVar?(name: string, variable: undefined | HostVariable): void | HostError;
+  varNames?(): string[];
+  /** e.g. `fileTest("-f", "/home/foo", "")` or `fileTest("-nt", x, y)` */
+  fileTest?(op: string, x: string, y: string): boolean | HostError;
+  /** `[[ -o optname ]]` */
+  optionSet?(name: string): boolean;
+  /** Run a command substitution e.g. `$( ls )` returning its stdout */
+  cmdSubst?(text: string): string | HostError;
+  /** List a directory of the virtual filesystem, for pathname expansion */
+  readDir?(path: string): { Name: string; IsDir: boolean }[] | HostError;
+  /** Run a command which is neither a function nor a bash builtin, see `createRunner` */
+  exec?(args: string[], dir: string): { Status: number; Stdout?: string; Stderr?: string } | HostError;
+  /** `undefined` or `null` if there is no such file */
+  readFile?(path: string): undefined | null | string | HostError;
+  writeFile?(path: string, data: string, append: boolean): void | HostError;
+  /** `undefined` or `null` if there is no such file */
+  stat?(path: string): undefined | null | { Name: string; IsDir: boolean; Size?: number } | HostError;
+}
+
+export interface HostVariable {
+  Kind: "string" | "indexed" | "associative" | "nameref";
+  Str?: string;
+  List?: string[];
+  Map?: Record<string, string>;
+  Local?: boolean;
+  Exported?: boolean;
+  ReadOnly?: boolean;
+}
+
+export interface HostError {
+  error: string;
+}
+
+export interface Pos {
+  Offset: number;
+  Line: number;
+  Col: number;
+}
+
+export interface VarAssign {
+  Name: string;
+  Unset: boolean;
+  Kind: "" | HostVariable["Kind"];
+  Value: string;
+  List: null | string[];
+  Map: null | Record<string, string>;
+  Local: boolean;
+  Exported: boolean;
+  ReadOnly: boolean;
+}
+
+export interface EvalResult {
+  Value: number;
+  /** Exit status of `(( … ))`, `let` or `[[ … ]]` */
+  Status: number;
+  Assigns: null | VarAssign[];
+  Error: null | {
+    /** e.g. `DivisionByZero`, `BadBase`, `ValueTooGreatForBase`, `Syntax` */
+    Kind: string;
+    Message: string;
+    Pos: Pos;
+    End: Pos;
+  };
+}
+
+export const EvalMode = {
+  Arithm: 0,
+  Test: 1,
+} as const;
+
+/**
+ * Evaluate e.g. `i++, x+=2`, `16#ff`, `(( x > 1 ))`, `let y=x*2` or `[[ -n $x && $y == a* ]]`.
+ */
+export function evalExpr(
+  text: string,
+  mode: (typeof EvalMode)[keyof typeof EvalMode],
+  host?: ParseShHost,
+): Promise<EvalResult> {
+  return callExport<EvalResult>("evalExpr", [text, mode], host);
+}
+
+export const ExpandMode = {
+  Fields: 0,
+  Literal: 1,
+  Document: 2,
+  Pattern: 3,
+} as const;
+
+export interface ExpandResult {
+  Fields: null | string[];
+  Assigns: null | VarAssign[];
+  Error: EvalResult["Error"];
+}
+
+/**
+ * Expand words e.g. `"$x" ${a[@]} ${var/pat/rep} ${!prefix*} $(cmd)` like bash,
+ * where variables and command substitutions are provided by `host`.
+ */
+export function expandWords(
+  text: string,
+  mode: (typeof ExpandMode)[keyof typeof ExpandMode] = ExpandMode.Fields,
+  host?: ParseShHost,
+): Promise<ExpandResult> {
+  return callExport<ExpandResult>("expandWords", [text, mode], host);
+}
+
+export const GlobFlag = {
+  ExtGlob: 1,
+  GlobStar: 2,
+  NullGlob: 4,
+  NoCaseGlob: 8,
+  DotGlob: 16,
+} as const;
+
+/**
+ * Expand words like `expandWords`, additionally globbing e.g. `/home/*.sh`, `npc-?` or `**` (globstar)
+ * against the directories provided by `host.readDir`.
+ * @param flags bitmask of `GlobFlag`
+ * @param cwd defaults to `$PWD` via `host.getVar`
+ */
+export function globWords(text: string, flags = 0, cwd = "", host?: ParseShHost): Promise<ExpandResult> {
+  return callExport<ExpandResult>("globWords", [text, cwd, flags], host);
+}
+
+export const PatternMode = {
+  Shortest: 1,
+  Filenames: 2,
+  Braces: 4,
+  EntireString: 8,
+  NoGlobCase: 16,
+  ExtGlob: 256,
+} as const;
+
+export interface PatternResult {
+  Regexp: string;
+  Flags: string;
+  Error: EvalResult["Error"];
+}
+
+/**
+ * Compile a shell pattern e.g. from `case` or `[[ x == pat ]]` once, then match in JS.
+ * An extglob `!(…)` is only supported at the end of an `EntireString` pattern e.g. `*.!(sh)`.
+ * @param mode bitmask of `PatternMode`
+ */
+export async function patternRegexp(pattern: string, mode: number = PatternMode.EntireString): Promise<RegExp> {
+  const { Regexp, Flags, Error } = await callExport<PatternResult>("patternRegexp", [pattern, mode]);
+  if (Error) {
+    throw new Error(`patternRegexp: ${Error.Message}`);
+  }
+  return new RegExp(Regexp, Flags);
+}
+
+export interface QuoteError {
+  /** `-1` if not specific to a byte */
+  ByteOffset: number;
+  Message: string;
+}
+
+export interface QuoteResult {
+  Values: {
+    Value: string;
+    Form: "" | "none" | "single" | "double" | "ansi";
+    Error: null | QuoteError;
+  }[];
+  /** All values joined by spaces, or empty if any could not be quoted */
+  Line: string;
+  Error: null | QuoteError;
+}
+
+/**
+ * Quote arbitrary values e.g. NPC names with spaces or JSON blobs, so generated
+ * shell code reparses to the same arguments.
+ */
+export function quote(values: string[], variant: LangVariant = LangVariant.LangBash): Promise<QuoteResult> {
+  return callExport<QuoteResult>("quote", [JSON.stringify(values), variant]);
+}
+
+export const DesugarPass = {
+  /** `syntax.Simplify` e.g. `$(( (x) ))` to `$((x))` */
+  Simplify: 1,
+  /** `let x=1 y++` to `(( x = 1, y++ ))` */
+  Let: 2,
+  /** `for ((i=0; …; i++))` to a `while` loop, unless its body uses `continue` */
+  CStyleFor: 4,
+  /** `time cmd` to `cmd` */
+  Time: 8,
+  /** `function f` and `f() cmd` to `f() { …; }` */
+  FuncDecl: 16,
+  /** `[ … ]` to `[[ … ]]` when operands are literal or quoted words without globs, and not for `-eq` etc. (`test` evaluates JavaScript) */
+  Test: 32,
+  /** `${x:-}` and `${x-}` to `$x`, which differ under `set -u`, so not included by `0` */
+  ParamExp: 64,
+  /** `{ cmd; }` to `cmd` */
+  Block: 128,
+} as const;
+
+export interface Rewrite {
+  Pass: string;
+  Pos: Pos;
+  End: Pos;
+  Before: string;
+  After: string;
+}
+
+export interface DesugarResult {
+  file: null | MvdanSh.File;
+  /** Printed desugared source */
+  Text: string;
+  Changes: Rewrite[];
+  parseError: null | { Filename?: string; Incomplete: boolean; Text: string };
+  Message: string;
+}
+
+/**
+ * Parse then rewrite into fewer node kinds, so the interpreter need not support them.
+ * Positions of synthesized nodes refer to the original source.
+ * @param passes bitmask of `DesugarPass`, where `0` means all except `ParamExp`
+ */
+export function desugar(
+  text: string,
+  {
+    filepath = "",
+    variant = LangVariant.LangBash,
+    passes = 0,
+  }: { filepath?: string; variant?: LangVariant; passes?: number } = {},
+): Promise<DesugarResult> {
+  return callExport<DesugarResult>("desugar", [filepath, text, variant, passes]);
+}
+
+export interface TextEdit {
+  Pos: Pos;
+  End: Pos;
+  NewText: string;
+}
+
+export interface Diagnostic {
+  /** e.g. `quote-expansion`, `unknown-module` */
+  Rule: string;
+  Severity: "error" | "warning" | "info" | "style";
+  Message: string;
+  Pos: Pos;
+  End: Pos;
+  Fix: null | { Message: string; Edits: TextEdit[] };
+}
+
+export interface LintOptions {
+  /** Module name to function names, e.g. from `session.modules`, else `run`/`map`/`import` are not checked */
+  Modules?: Record<string, string[]>;
+  /** Rule IDs to skip, see also `# lint-disable rule-id` and `# lint-disable-file rule-id` */
+  Disable?: string[];
+  /** Rule ID to severity */
+  Severities?: Record<string, Diagnostic["Severity"]>;
+}
+
+export interface LintResult {
+  Diagnostics: Diagnostic[];
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * Lint shell code e.g. unquoted `$var`, unchecked `cd`, unused variables, `$?` misuse,
+ * or `run`/`map`/`import` of unknown modules.
+ */
+export function lint(
+  text: string,
+  {
+    filepath = "",
+    variant = LangVariant.LangBash,
+    ...options
+  }: LintOptions & { filepath?: string; variant?: LangVariant } = {},
+): Promise<LintResult> {
+  return callExport<LintResult>("lint", [filepath, text, variant, JSON.stringify(options)]);
+}
+
+export async function lintRules(): Promise<
+  { ID: string; Severity: Diagnostic["Severity"]; Summary: string; Fixable: boolean }[]
+> {
+  const { Rules } = await callExport<{ Rules: Awaited<ReturnType<typeof lintRules>> }>("lintRules", []);
+  return Rules;
+}
+
+export interface SymbolRef {
+  /** Definitions e.g. `assign`, `local`, `declare`, `read`, `for`, `arithm`; references `param`, `indirect`, `arithm`, `test` */
+  Kind: string;
+  Pos: Pos;
+  End: Pos;
+  /** Innermost enclosing scope */
+  Scope: number;
+}
+
+export interface SymbolTable {
+  /** `Scopes[0]` is the file, others are function bodies */
+  Scopes: { ID: number; Parent: number; Function: number; Pos: Pos; End: Pos }[];
+  Functions: {
+    Name: string;
+    Pos: Pos;
+    End: Pos;
+    BodyPos: Pos;
+    BodyEnd: Pos;
+    Scope: number;
+    BodyScope: number;
+    /** e.g. `["1", "@", "#"]` */
+    Positionals: string[];
+  }[];
+  /** A `local` is a distinct variable from a global of the same name */
+  Variables: {
+    Name: string;
+    Scope: number;
+    Kind: "" | "indexed" | "associative" | "nameref";
+    Local: boolean;
+    Exported: boolean;
+    ReadOnly: boolean;
+    Integer: boolean;
+    Defs: SymbolRef[];
+    Refs: SymbolRef[];
+  }[];
+  /** Calls of functions declared in the file, where `Function` indexes `Functions` */
+  Calls: { Name: string; Pos: Pos; End: Pos; Scope: number; Function: number }[];
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * Collect functions, variables and their definition/use sites e.g. for completion or `declare`.
+ */
+export function symbols(
+  text: string,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<SymbolTable> {
+  return callExport<SymbolTable>("symbols", [filepath, text, variant]);
+}
+
+export interface RenameResult {
+  Kind: "" | "variable" | "function";
+  OldName: string;
+  /** One edit per definition and reference, sorted by offset */
+  Edits: TextEdit[];
+  Error: null | { Kind: "NoSymbol" | "InvalidName" | "Conflict"; Message: string };
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * Rename the variable or function under `offset` e.g. `FuncDecl` names, call sites, `$x`, `local x`, `unset x`,
+ * respecting `local` shadowing.
+ * @param offset UTF-8 byte offset, as in `Pos.Offset`
+ */
+export function rename(
+  text: string,
+  offset: number,
+  newName: string,
+  variant: LangVariant = LangVariant.LangBash,
+): Promise<RenameResult> {
+  return callExport<RenameResult>("rename", [text, offset, newName, variant]);
+}
+
+export interface CommandSignature {
+  Name: string;
+  /** e.g. `{ Name: "--force" }` or `{ Name: "-s", Arg: true, ArgKind: "name" }` */
+  Flags?: { Name: string; Arg?: boolean; ArgKind?: ArgKind }[];
+  /** Do not check flags */
+  AnyFlags?: boolean;
+  MinArgs?: number;
+  /** Unbounded if omitted */
+  MaxArgs?: number;
+  /** Kind of each operand */
+  Args?: ArgKind[];
+  /** Kind of operands beyond `Args` */
+  Rest?: ArgKind;
+}
+
+export type ArgKind = "" | "string" | "pid" | "number" | "name" | "path" | "module-key";
+
+export interface CommandRegistry {
+  Commands: CommandSignature[];
+  /** Module name to function names, for `import` and `module-key` arguments */
+  Modules?: Record<string, string[]>;
+}
+
+/**
+ * Check every literal command against `registry`, reporting `unknown-command`, `unknown-flag`,
+ * `missing-flag-arg`, `too-few-args`, `too-many-args` and `bad-arg` before execution.
+ * Functions defined or imported by the script are not checked.
+ */
+export function validate(
+  text: string,
+  registry: CommandRegistry,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<LintResult> {
+  return callExport<LintResult>("validate", [filepath, text, variant, JSON.stringify(registry)]);
+}
+
+export interface DepsRef {
+  Kind: "source" | "import" | "run" | "map" | "call" | "eval";
+  From: string;
+  /** Enclosing function, if any */
+  Function: string;
+  /** e.g. sourced path, module, `module.fn` or function name */
+  Target: string;
+  /** File defining the target, if among the inputs */
+  DefinedIn: string;
+  Module: string;
+  /** Not statically resolvable e.g. `source $f`, `$cmd`, `eval`, or a relative `source` without `cwd` */
+  Dynamic: boolean;
+  Pos: Pos;
+  End: Pos;
+}
+
+export interface DepsGraph {
+  Functions: {
+    Name: string;
+    File: string;
+    /** Set if induced by `import` */
+    Module: string;
+    Pos: Pos;
+    End: Pos;
+    /** Static call count, so `0` suggests dead code unless called dynamically */
+    Callers: number;
+  }[];
+  Refs: DepsRef[];
+  /** Sourced files precede their sourcers */
+  LoadOrder: string[];
+  /** Per input file */
+  ParseErrors: (null | NonNullable<DesugarResult["parseError"]>)[];
+  Message: string;
+}
+
+/**
+ * Extract references between scripts e.g. `source /etc/util.sh`, `import fn from util`,
+ * `run core spawn` and calls of functions defined by some file.
+ * @param modules module name to function names, to resolve `import util` and disambiguate `map`
+ * @param cwd resolves relative `source` targets as bash does, else they are `Dynamic`
+ */
+export function deps(
+  files: { Path: string; Text: string }[],
+  modules: Record<string, string[]> = {},
+  variant: LangVariant = LangVariant.LangBash,
+  cwd = "",
+): Promise<DepsGraph> {
+  return callExport<DepsGraph>("deps", [JSON.stringify({ Files: files, Modules: modules, Variant: variant, Cwd: cwd })]);
+}
+
+export interface DocEntry {
+  Kind: "function" | "variable" | "case-item";
+  /** Function or variable name, else case patterns e.g. `start|stop` */
+  Name: string;
+  /** Enclosing function, if any */
+  Function: string;
+  Description: string;
+  /** `@arg name description` */
+  Args: { Name: string; Description: string }[];
+  /** `@example`, continuing until the next tag */
+  Examples: string[];
+  /** `@returns description` */
+  Returns: string;
+  /** Other tags e.g. `@deprecated` */
+  Tags: { Tag: string; Text: string }[];
+  Pos: Pos;
+  End: Pos;
+  CommentPos: null | Pos;
+  CommentEnd: null | Pos;
+}
+
+/**
+ * Extract doc comments i.e. contiguous leading comments and a trailing same-line comment,
+ * for every function, and commented assignments and case items, e.g. for `help`.
+ */
+export async function docs(
+  text: string,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<{ Docs: DocEntry[]; parseError: DesugarResult["parseError"]; Message: string }> {
+  return callExport("docs", [filepath, text, variant]);
+}
+
+export interface RunOptions {
+  filepath?: string;
+  variant?: LangVariant;
+  /** Absolute working directory */
+  dir?: string;
+  /** Positional parameters */
+  params?: string[];
+  /** Commands run via `host.exec` even though bash implements them e.g. `test`, which evaluates JavaScript */
+  builtins?: string[];
+}
+
+export interface RunResult {
+  Handle: number;
+  /** Index of the next top-level statement */
+  Next: number;
+  Done: boolean;
+  /** Exit status of the last statement run */
+  Status: number;
+  /** Whether `exit` was run */
+  Exited: boolean;
+  Stdout: string;
+  Stderr: string;
+  Assigns: VarAssign[];
+  /** Fatal error e.g. a pipeline, which wasm cannot run, or a variable the host rejects */
+  Error: string;
+  /** Range of the next statement */
+  Pos: null | Pos;
+  End: null | Pos;
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * Run a script with mvdan's interpreter instead of `semantics.ts`, e.g. to compare behaviour.
+ * - commands other than functions and bash builtins are delegated to `host.exec` e.g. `run` or `npc`
+ * - variables are read via `host.getVar` and changes are written back via `host.setVar`
+ * - files are accessed via `host.readFile`, `host.writeFile`, `host.stat` and `host.readDir`
+ *
+ * The runner keeps its own wasm instance until `free` is called.
+ * Pipelines, heredocs and here-strings are not supported in wasm.
+ */
+export async function createRunner(
+  text: string,
+  { filepath = "", variant = LangVariant.LangBash, dir = "", params = [], builtins = [] }: RunOptions = {},
+  host: ParseShHost = {},
+) {
+  const { wasm } = await loadWasm();
+  const exports = wasm.exports as unknown as WasmExports;
+  const options = JSON.stringify({ Dir: dir, Params: params, Builtins: builtins });
+  const initial = invokeExport<RunResult>(exports, "runnerNew", [filepath, text, variant, options], host);
+  const handle = initial.Handle;
+
+  return {
+    initial,
+    /** Run at most `count` top-level statements */
+    step: (count = 1) =>
+      handle === 0 ? initial : invokeExport<RunResult>(exports, "runnerStep", [handle, Math.max(count, 1)], host),
+    /** Run all remaining statements */
+    run: () => (handle === 0 ? initial : invokeExport<RunResult>(exports, "runnerStep", [handle, 0], host)),
+    free: () => {
+      if (handle !== 0) {
+        exports.runnerFree(handle);
+      }
+    },
+  };
+}
+
+/** Restricts what an untrusted script may do, where omitted fields allow everything */
+export interface Policy {
+  /** If non-empty, only these commands and the script's functions may run */
+  AllowCommands?: string[];
+  /**
+   * Also checked through wrappers e.g. `env rm` or `nohup rm`, though e.g. `find -exec rm`
+   * or a script run by name is missed, so prefer `AllowCommands` for untrusted scripts
+   */
+  DenyCommands?: string[];
+  /** e.g. `sleep 1 &` */
+  DenyBackground?: boolean;
+  DenyCoproc?: boolean;
+  /** `path.Match` patterns, where a directory also matches its descendants e.g. `/etc` */
+  DenyRedirectPaths?: string[];
+  /** `$( … )`, backticks and `<( … )` */
+  DenyCmdSubst?: boolean;
+  /**
+   * `eval`, `source`, `.` or `sh -c` of a word which is not static e.g. `eval "$x"`, or of code which does not parse.
+   * Static code is checked like the script.
+   */
+  DenyDynamicEval?: boolean;
+  /** If positive, the deepest chain of nested calls of the script's functions, and recursion between them is reported */
+  MaxCallDepth?: number;
+}
+
+/**
+ * Report every construct a policy forbids, so a script from the map editor, examples or
+ * shared profiles can be refused or sandboxed before it runs.
+ * Rules: `denied-command`, `dynamic-command`, `background`, `coproc`, `redirect-path`,
+ * `dynamic-redirect`, `cmd-subst`, `dynamic-eval`, `recursion` and `call-depth`.
+ */
+export function checkPolicy(
+  text: string,
+  policy: Policy,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<LintResult> {
+  return callExport<LintResult>("checkPolicy", [filepath, text, variant, JSON.stringify(policy)]);
+}
+
+export interface QueryMatch {
+  /** e.g. `CallExpr` */
+  Type: string;
+  /** Location in the parsed `File` e.g. `Stmts.0.Cmd.Args.1` */
+  Path: string;
+  Pos: Pos;
+  End: Pos;
+}
+
+export interface QueryResult {
+  Matches: QueryMatch[];
+  /** Invalid selector */
+  Error: string;
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * Find the nodes matching a selector, in source order e.g.
+ * - `CallExpr[arg0=npc]` calls of `npc`, also `[arg1=…]` or `[argc=2]`
+ * - `WhileClause CallExpr` calls inside loops, or `WhileClause > Stmt` their direct statements
+ * - `Assign[name=PATH]` or `BinaryCmd[op=&&]` compare a field as text, also via `!=`, `^=`, `$=` or `*=`
+ * - `FuncDecl:has(CallExpr[arg0=exit])` or `CallExpr:not([arg0=echo])`
+ * - `Word[text="a b"]` compares the node's source text
+ */
+export function query(
+  text: string,
+  selector: string,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<QueryResult> {
+  return callExport<QueryResult>("query", [filepath, text, variant, selector]);
+}
+
+export interface DiffChange {
+  Kind: "function" | "assignment" | "statement";
+  /** Function or variable name, else empty */
+  Name: string;
+  Change: "added" | "removed" | "modified";
+  /** Null if added */
+  OldPos: null | Pos;
+  OldEnd: null | Pos;
+  /** Null if removed */
+  NewPos: null | Pos;
+  NewEnd: null | Pos;
+}
+
+export interface DiffResult {
+  Changes: DiffChange[];
+  parseError: DesugarResult["parseError"];
+  /** Whether `parseError` is in the old text, rather than the new */
+  ParseErrorInOld: boolean;
+  Message: string;
+}
+
+/**
+ * Compare the top-level functions, assignments and statements of two versions of a script,
+ * ignoring whitespace and comments, so e.g. hot-reload can redefine only what changed.
+ */
+export function diff(
+  oldText: string,
+  newText: string,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<DiffResult> {
+  return callExport<DiffResult>("diff", [filepath, oldText, newText, variant]);
+}
+
+export interface BatchItem {
+  /** Used as the file path */
+  name: string;
+  text: string;
+  /** Omitted fields fall back to the batch's defaults, whereas `aliases` are added to them */
+  options?: ShParserOptions;
+}
+
+export interface BatchItemResult {
+  name: string;
+  /** The expanded text if aliases were expanded */
+  text: string;
+  file: MvdanSh.File;
+  message: string;
+  aliases: AliasExpansion[];
+  parseError: null | ParseError;
+  limitError: null | LimitError;
+  /** The variant parsed with, detected if `LangAuto` was passed */
+  variant: LangVariant;
+  variantSource: "option" | "directive" | "shebang" | "extension" | "default";
+  /** Indexed by node `ID`, if `nodeTable` was passed */
+  nodes: null | NodeInfo[];
+}
+
+interface RawBatchResult {
+  results: {
+    file: { Name: string; Stmts: unknown[]; ID: number; ParentID: number };
+    text: string;
+    aliases: null | AliasExpansion[];
+    parseError: null | ConstructorParameters<typeof ParseError>[0];
+    limitError: null | ConstructorParameters<typeof LimitError>[0];
+    variant: LangVariant;
+    variantSource: BatchItemResult["variantSource"];
+    nodes: null | NodeInfo[];
+    message: string;
+  }[];
+  message: string;
+}
+
+/**
+ * Parse many scripts in one call e.g. profiles and generated wrappers at startup.
+ * Each item has its own result and errors, so one invalid script does not affect the others.
+ */
+export async function parseBatch(items: BatchItem[], defaults: ShParserOptions = {}): Promise<BatchItemResult[]> {
+  const batch = {
+    defaults: { keepComments: true, variant: LangVariant.LangBash, ...defaults },
+    items,
+  };
+  const { results, message } = await callExport<RawBatchResult>("parseBatch", [JSON.stringify(batch)]);
+  if (message) {
+    throw new Error(`parseBatch: ${message}`);
+  }
+  return results.map(({ file, text, aliases, parseError, limitError, variant, variantSource, nodes, message }, i) => ({
+    name: items[i].name,
+    text,
+    file: {
+      type: "File",
+      Name: file.Name,
+      Stmts: file.Stmts as MvdanSh.Stmt[],
+      ID: file.ID,
+      ParentID: file.ParentID,
+    },
+    message,
+    aliases: aliases ?? [],
+    parseError: parseError ? new ParseError(parseError) : null,
+    limitError: limitError ? new LimitError(limitError) : null,
+    variant,
+    variantSource,
+    nodes,
+  }));
+}
+
+export interface StmtResult {
+  Handle: number;
+  /** Null once done */
+  Stmt: null | MvdanSh.Stmt;
+  Pos: null | Pos;
+  End: null | Pos;
+  /** Expansions within the statement, where ranges are in the text with aliases expanded so far */
+  Aliases: AliasExpansion[];
+  Done: boolean;
+  /** A syntax error is only reported once parsing reaches it, after the statements before it */
+  parseError: DesugarResult["parseError"];
+  limitError: null | ConstructorParameters<typeof LimitError>[0];
+  Message: string;
+}
+
+/**
+ * Parse a long script statement by statement, so earlier statements can run before later ones are parsed,
+ * and a syntax error near the end does not prevent earlier definitions from loading, as in bash.
+ * Aliases are expanded as by `parse`, and limits other than `MaxInputBytes` apply per statement.
+ *
+ * The stream keeps its own wasm instance until `free` is called, or it is done.
+ */
+export async function createStmtStream(
+  text: string,
+  {
+    filepath = "",
+    keepComments = true,
+    variant = LangVariant.LangBash,
+    aliases = {},
+    limits = {},
+  }: Pick<ShParserOptions, "aliases" | "limits"> & {
+    filepath?: string;
+    keepComments?: boolean;
+    variant?: LangVariant;
+  } = {},
+) {
+  const { wasm } = await loadWasm();
+  const exports = wasm.exports as unknown as WasmExports;
+  const { Handle: handle, Message: message } = invokeExport<StmtResult>(exports, "stmtStreamNew", [
+    filepath,
+    text,
+    keepComments,
+    variant,
+    JSON.stringify(aliases),
+    JSON.stringify(limits),
+  ]);
+  if (handle === 0) {
+    throw new Error(`createStmtStream: ${message}`);
+  }
+  let done = false;
+
+  const free = () => {
+    if (!done) {
+      done = true;
+      exports.stmtStreamFree(handle);
+    }
+  };
+
+  return {
+    /** Parse the next top-level statement */
+    next(): StmtResult {
+      if (done) {
+        return { ...emptyStmtResult, Handle: handle };
+      }
+      const result = invokeExport<StmtResult>(exports, "nextStmt", [handle]);
+      if (result.Done) {
+        free();
+      }
+      return result;
+    },
+    free,
+  };
+}
+
+const emptyStmtResult: StmtResult = {
+  Handle: 0,
+  Stmt: null,
+  Pos: null,
+  End: null,
+  Aliases: [],
+  Done: true,
+  parseError: null,
+  limitError: null,
+  Message: "",
+};
+
+export interface PortabilityIssue {
+  /** e.g. `[[ ]]`, `array`, `process substitution`, or `syntax` if only reparsing failed */
+  Construct: string;
+  /** Node type e.g. `TestClause`, or empty if found by reparsing */
+  Type: string;
+  Message: string;
+  Pos: Pos;
+  End: Pos;
+  /** Variants supporting the construct e.g. `["bash", "mksh", "bats"]` */
+  Variants: ("bash" | "posix" | "mksh" | "bats")[];
+}
+
+export interface PortabilityResult {
+  Issues: PortabilityIssue[];
+  /** Variants under which the whole script parses */
+  Variants: PortabilityIssue["Variants"];
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * List constructs a target variant lacks, e.g. bashisms such as `[[ ]]`, arrays, `$'…'`, `local`,
+ * `<( … )`, `{a..b}`, `(( ))`, `select` or extglob when sharing a script as POSIX sh.
+ */
+export function checkPortability(
+  text: string,
+  target: LangVariant = LangVariant.LangPOSIX,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<PortabilityResult> {
+  return callExport<PortabilityResult>("checkPortability", [filepath, text, variant, target]);
+}
+
+/** What a runtime can execute, where an omitted or empty list is not checked */
+export interface Capabilities {
+  /** Node types e.g. `CallExpr` or `ProcSubst`, where `File`, `Stmt`, `Word` and `Lit` are implied */
+  Nodes?: string[];
+  /**
+   * Operators e.g. `&&`, or `BinaryCmd:|&` for a specific node type, including flags
+   * e.g. `ForClause:select`, `TimeClause:-p`, `Stmt:&`, `ParamExp:#` or `DeclClause:local`
+   */
+  Operators?: string[];
+}
+
+/**
+ * Report every node or operator the runtime cannot execute, e.g. ExtGlob, ProcSubst, coproc, select or `time -p`,
+ * so a script can be rejected before starting any process.
+ * Rules: `unsupported-node` and `unsupported-operator`.
+ */
+export function checkCapabilities(
+  text: string,
+  capabilities: Capabilities,
+  { filepath = "", variant = LangVariant.LangBash }: { filepath?: string; variant?: LangVariant } = {},
+): Promise<LintResult> {
+  return callExport<LintResult>("checkCapabilities", [filepath, text, variant, JSON.stringify(capabilities)]);
+}
+
+/** An executable statement, where `ID` is that of `parse` given the same `keepComments` */
+export interface LineEntry {
+  /** Of the statement, or the item for a case arm, else -1 e.g. within `<( … )` */
+  ID: number;
+  Kind: "command" | "condition" | "loop" | "case-arm";
+  /** Command type e.g. `CallExpr`, or "" for a statement of only redirections */
+  Type: string;
+  /** Innermost enclosing function, else "" */
+  Function: string;
+  Pos: Pos;
+  End: Pos;
+}
+
+export interface BranchArm {
+  /** `then`, `elif`, `else`, `left`, `right` or the patterns of a case item */
+  Label: string;
+  /** Of the case item, else -1 */
+  ID: number;
+  /** IDs of the arm's statements, where the arm is taken once any of them runs */
+  Stmts: number[];
+  /** Taken when no other arm is, e.g. a missing `else` or no case item matching */
+  Implicit: boolean;
+  Pos: null | Pos;
+  End: null | Pos;
+}
+
+export interface Branch {
+  ID: number;
+  Kind: "if" | "case" | "&&" | "||";
+  Function: string;
+  Pos: Pos;
+  End: Pos;
+  Arms: BranchArm[];
+}
+
+export interface LineTableResult {
+  /** In source order */
+  Entries: LineEntry[];
+  Branches: Branch[];
+  parseError: DesugarResult["parseError"];
+  Message: string;
+}
+
+/**
+ * List the executable statements and the branches between them, e.g. for breakpoints, stepping,
+ * or line and branch coverage, referring to nodes by `ID` rather than object identity.
+ */
+export function lineTable(
+  text: string,
+  {
+    filepath = "",
+    keepComments = true,
+    variant = LangVariant.LangBash,
+  }: { filepath?: string; keepComments?: boolean; variant?: LangVariant } = {},
+): Promise<LineTableResult> {
+  return callExport<LineTableResult>("lineTable", [filepath, text, keepComments, variant]);
+}
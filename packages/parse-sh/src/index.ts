@@ -1,3 +1,4 @@
+export * from "./exports";
 export type { JSh } from "./jsh";
 export * from "./jsh.model";
 export type { MvdanSh } from "./mvdan-sh";
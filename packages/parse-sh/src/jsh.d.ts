@@ -88,6 +88,10 @@ export declare namespace JSh {
     meta: BaseMeta;
     /** Reference to parent node  */
     parent: null | ParsedSh;
+    /** Pre-order index within the parsed file, which survives `cloneParsed` unlike `parent` */
+    ID: number;
+    /** `ID` of the parent node, or -1 for the file */
+    ParentID: number;
     /** Used for expansion */
     string?: string;
     /** Used to calculate actual exit codes */
@@ -133,13 +133,15 @@ export class ConvertMvdanShToJsh {
   }
 
   /** Convert to our notion of base parsed node. */
-  private base = ({ Pos: _, End: __ }: MvdanSh.BaseNode): JSh.BaseNode => {
+  private base = ({ Pos: _, End: __, ID, ParentID }: MvdanSh.BaseNode): JSh.BaseNode => {
     // console.log({ Pos, End });
     return {
       // Pos: this.pos(Pos()),
       // End: this.pos(End()),
       meta: this.mockMeta, // Gets mutated
       parent: null, // Gets overwritten
+      ID,
+      ParentID,
     };
   };
 
@@ -163,12 +165,14 @@ export class ConvertMvdanShToJsh {
   private ArithmCmd = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Left,
     Right,
     Unsigned,
     X,
   }: MvdanSh.ArithmCmd): JSh.ArithmCmd => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ArithmCmd",
     Left: this.pos(Left),
     Right: this.pos(Right),
@@ -179,13 +183,15 @@ export class ConvertMvdanShToJsh {
   private ArithmExp = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Bracket,
     Left,
     Right,
     Unsigned,
     X,
   }: MvdanSh.ArithmExp): JSh.ArithmExp => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ArithmExp",
     Bracket,
     Left: this.pos(Left),
@@ -194,8 +200,8 @@ export class ConvertMvdanShToJsh {
     X: this.ArithmExpr(X),
   });
 
-  private ArrayElem = ({ Pos, End, Comments, Index, Value }: MvdanSh.ArrayElem): JSh.ArrayElem => ({
-    ...this.base({ Pos, End }),
+  private ArrayElem = ({ Pos, End, ID, ParentID, Comments, Index, Value }: MvdanSh.ArrayElem): JSh.ArrayElem => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ArrayElem",
     Comments: Comments.map(this.Comment),
     Index: Index ? this.ArithmExpr(Index) : null,
@@ -216,12 +222,14 @@ export class ConvertMvdanShToJsh {
   private ArrayExpr = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Elems,
     Last,
     Lparen,
     Rparen,
   }: MvdanSh.ArrayExpr): JSh.ArrayExpr => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ArrayExpr",
     Elems: Elems.map(this.ArrayElem),
     Last: Last.map(this.Comment),
@@ -232,6 +240,8 @@ export class ConvertMvdanShToJsh {
   private Assign = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Append,
     // biome-ignore lint/suspicious/noShadowRestrictedNames: too strict
     Array,
@@ -240,7 +250,7 @@ export class ConvertMvdanShToJsh {
     Name,
     Value,
   }: MvdanSh.Assign): JSh.Assign => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Assign",
     Append,
     Array: Array ? this.ArrayExpr(Array) : null,
@@ -254,12 +264,14 @@ export class ConvertMvdanShToJsh {
   private BinaryArithm = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Op,
     OpPos,
     X,
     Y,
   }: MvdanSh.BinaryArithm): JSh.BinaryArithm => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "BinaryArithm",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -267,8 +279,8 @@ export class ConvertMvdanShToJsh {
     Y: this.ArithmExpr(Y),
   });
 
-  private BinaryCmd = ({ Pos, End, Op, OpPos, X, Y }: MvdanSh.BinaryCmd): JSh.BinaryCmd => ({
-    ...this.base({ Pos, End }),
+  private BinaryCmd = ({ Pos, End, ID, ParentID, Op, OpPos, X, Y }: MvdanSh.BinaryCmd): JSh.BinaryCmd => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "BinaryCmd",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -276,8 +288,8 @@ export class ConvertMvdanShToJsh {
     Y: this.Stmt(Y),
   });
 
-  private BinaryTest = ({ Pos, End, Op, OpPos, X, Y }: MvdanSh.BinaryTest): JSh.BinaryTest => ({
-    ...this.base({ Pos, End }),
+  private BinaryTest = ({ Pos, End, ID, ParentID, Op, OpPos, X, Y }: MvdanSh.BinaryTest): JSh.BinaryTest => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "BinaryTest",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -285,8 +297,8 @@ export class ConvertMvdanShToJsh {
     Y: this.TestExpr(Y),
   });
 
-  private Block = ({ Pos, End, Lbrace, Rbrace, Stmts, Last }: MvdanSh.Block): JSh.Block => ({
-    ...this.base({ Pos, End }),
+  private Block = ({ Pos, End, ID, ParentID, Lbrace, Rbrace, Stmts, Last }: MvdanSh.Block): JSh.Block => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Block",
     Lbrace: this.pos(Lbrace),
     Rbrace: this.pos(Rbrace),
@@ -294,8 +306,8 @@ export class ConvertMvdanShToJsh {
     Last: Last.map(this.Comment),
   });
 
-  private CallExpr = ({ Pos, End, Args, Assigns }: MvdanSh.CallExpr): JSh.CallExpr => ({
-    ...this.base({ Pos, End }),
+  private CallExpr = ({ Pos, End, ID, ParentID, Args, Assigns }: MvdanSh.CallExpr): JSh.CallExpr => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CallExpr",
     Args: Args.map(this.Word),
     Assigns: Assigns.map(this.Assign),
@@ -304,13 +316,15 @@ export class ConvertMvdanShToJsh {
   private CaseClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Case,
     Esac,
     Items,
     Last,
     Word,
   }: MvdanSh.CaseClause): JSh.CaseClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CaseClause",
     Case: this.pos(Case),
     Esac: this.pos(Esac),
@@ -322,13 +336,15 @@ export class ConvertMvdanShToJsh {
   private CaseItem = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Comments,
     Op,
     OpPos,
     Patterns,
     Stmts,
   }: MvdanSh.CaseItem): JSh.CaseItem => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CaseItem",
     Comments: Comments.map(this.Comment),
     Op: this.op(Op),
@@ -340,13 +356,15 @@ export class ConvertMvdanShToJsh {
   private CmdSubst = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Left,
     ReplyVar,
     Right,
     Stmts,
     TempFile,
   }: MvdanSh.CmdSubst): JSh.CmdSubst => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CmdSubst",
     Left: this.pos(Left),
     ReplyVar,
@@ -355,8 +373,8 @@ export class ConvertMvdanShToJsh {
     TempFile,
   });
 
-  private Comment = ({ Pos, End, Hash, Text }: MvdanSh.Comment): JSh.Comment => ({
-    ...this.base({ Pos, End }),
+  private Comment = ({ Pos, End, ID, ParentID, Hash, Text }: MvdanSh.Comment): JSh.Comment => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Comment",
     Hash: this.pos(Hash),
     Text,
@@ -365,13 +383,15 @@ export class ConvertMvdanShToJsh {
   private CStyleLoop = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Cond,
     Init,
     Lparen,
     Post,
     Rparen,
   }: MvdanSh.CStyleLoop): JSh.CStyleLoop => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CStyleLoop",
     Cond: this.ArithmExpr(Cond),
     Init: this.ArithmExpr(Init),
@@ -416,11 +436,13 @@ export class ConvertMvdanShToJsh {
   private CoprocClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Coproc,
     Name,
     Stmt,
   }: MvdanSh.CoprocClause): JSh.CoprocClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "CoprocClause",
     Coproc: this.pos(Coproc),
     Name: Name ? this.Lit(Name) : null,
@@ -430,12 +452,14 @@ export class ConvertMvdanShToJsh {
   private DblQuoted = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Dollar,
     Parts,
     Left,
     Right,
   }: MvdanSh.DblQuoted): JSh.DblQuoted => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "DblQuoted",
     Dollar,
     Parts: Parts.map(this.WordPart),
@@ -443,17 +467,17 @@ export class ConvertMvdanShToJsh {
     Right: this.pos(Right),
   });
 
-  private DeclClause = ({ Pos, End, Args, Variant }: MvdanSh.DeclClause): JSh.DeclClause => {
+  private DeclClause = ({ Pos, End, ID, ParentID, Args, Variant }: MvdanSh.DeclClause): JSh.DeclClause => {
     return {
-      ...this.base({ Pos, End }),
+      ...this.base({ Pos, End, ID, ParentID }),
       type: "DeclClause",
       Args: Args.map(this.Assign),
       Variant: this.Lit(Variant),
     };
   };
 
-  private ExtGlob = ({ Pos, End, Op, OpPos, Pattern }: MvdanSh.ExtGlob): JSh.ExtGlob => ({
-    ...this.base({ Pos, End }),
+  private ExtGlob = ({ Pos, End, ID, ParentID, Op, OpPos, Pattern }: MvdanSh.ExtGlob): JSh.ExtGlob => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ExtGlob",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -463,8 +487,8 @@ export class ConvertMvdanShToJsh {
   /**
    * Previously arg had functions {Pos} and {End}.
    */
-  public File = ({ Name, Stmts }: MvdanSh.File): JSh.FileWithMeta => ({
-    ...this.base({ Pos: mockPos, End: mockPos }),
+  public File = ({ Name, Stmts, ID, ParentID }: MvdanSh.File): JSh.FileWithMeta => ({
+    ...this.base({ Pos: mockPos, End: mockPos, ID, ParentID }),
     type: "File",
     Name,
     Stmts: Stmts.map((x) => this.Stmt(x)),
@@ -481,6 +505,8 @@ export class ConvertMvdanShToJsh {
   private ForClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Do,
     DonePos,
     DoPos,
@@ -488,7 +514,7 @@ export class ConvertMvdanShToJsh {
     Loop,
     Select,
   }: MvdanSh.ForClause): JSh.ForClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ForClause",
     Do: Do.map((Stmt) => this.Stmt(Stmt)),
     DonePos: this.pos(DonePos),
@@ -501,12 +527,14 @@ export class ConvertMvdanShToJsh {
   private FuncDecl = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Body,
     Name,
     Position,
     RsrvWord,
   }: MvdanSh.FuncDecl): JSh.FuncDecl => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "FuncDecl",
     Body: this.Stmt(Body),
     Name: this.Lit(Name),
@@ -517,6 +545,8 @@ export class ConvertMvdanShToJsh {
   private IfClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Cond,
     CondLast,
     Else,
@@ -526,7 +556,7 @@ export class ConvertMvdanShToJsh {
     ThenPos,
     Last,
   }: MvdanSh.IfClause): JSh.IfClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "IfClause",
     ThenPos: this.pos(ThenPos),
     FiPos: this.pos(FiPos),
@@ -540,8 +570,8 @@ export class ConvertMvdanShToJsh {
     Last: Last.map(this.Comment),
   });
 
-  private LetClause = ({ Pos, End, Exprs, Let }: MvdanSh.LetClause): JSh.LetClause => ({
-    ...this.base({ Pos, End }),
+  private LetClause = ({ Pos, End, ID, ParentID, Exprs, Let }: MvdanSh.LetClause): JSh.LetClause => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "LetClause",
     Exprs: Exprs.map(this.ArithmExpr),
     Let: this.pos(Let),
@@ -550,11 +580,13 @@ export class ConvertMvdanShToJsh {
   private Lit = <Values extends string = string>({
     Pos,
     End,
+    ID,
+    ParentID,
     Value,
     ValueEnd,
     ValuePos,
   }: MvdanSh.Lit): JSh.Lit<Values> => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Lit",
     Value: Value as Values,
     ValueEnd: this.pos(ValueEnd),
@@ -571,6 +603,8 @@ export class ConvertMvdanShToJsh {
   private ParamExp = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Dollar,
     Excl,
     Exp,
@@ -584,7 +618,7 @@ export class ConvertMvdanShToJsh {
     Slice,
     Width,
   }: MvdanSh.ParamExp): JSh.ParamExp => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ParamExp",
     Dollar: this.pos(Dollar),
     Excl,
@@ -622,19 +656,21 @@ export class ConvertMvdanShToJsh {
   private ParenArithm = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Lparen,
     Rparen,
     X,
   }: MvdanSh.ParenArithm): JSh.ParenArithm => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ParenArithm",
     Lparen: this.pos(Lparen),
     Rparen: this.pos(Rparen),
     X: this.ArithmExpr(X),
   });
 
-  private ParenTest = ({ Pos, End, Lparen, Rparen, X }: MvdanSh.ParenTest): JSh.ParenTest => ({
-    ...this.base({ Pos, End }),
+  private ParenTest = ({ Pos, End, ID, ParentID, Lparen, Rparen, X }: MvdanSh.ParenTest): JSh.ParenTest => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ParenTest",
     Lparen: this.pos(Lparen),
     Rparen: this.pos(Rparen),
@@ -644,12 +680,14 @@ export class ConvertMvdanShToJsh {
   private ProcSubst = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Op,
     OpPos,
     Rparen,
     Stmts,
   }: MvdanSh.ProcSubst): JSh.ProcSubst => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "ProcSubst",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -657,25 +695,49 @@ export class ConvertMvdanShToJsh {
     Stmts: Stmts.map((Stmt) => this.Stmt(Stmt)),
   });
 
-  private Redirect = ({ Pos, End, Hdoc, N, Op, OpPos, Word }: MvdanSh.Redirect): JSh.Redirect => ({
-    ...this.base({ Pos, End }),
+  private Redirect = ({
+    Pos,
+    End,
+    ID,
+    ParentID,
+    Hdoc,
+    N,
+    Op,
+    OpPos,
+    Word,
+    Fd,
+    Heredoc,
+  }: MvdanSh.Redirect): JSh.Redirect => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Redirect",
     Hdoc: Hdoc ? this.Word(Hdoc) : null,
     N: N ? this.Lit(N) : null,
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
     Word: this.Word(Word),
+    Fd,
+    Heredoc: Heredoc
+      ? {
+          ...Heredoc,
+          BodyPos: Heredoc.BodyPos && this.pos(Heredoc.BodyPos),
+          BodyEnd: Heredoc.BodyEnd && this.pos(Heredoc.BodyEnd),
+          TerminatorPos: Heredoc.TerminatorPos && this.pos(Heredoc.TerminatorPos),
+          TerminatorEnd: Heredoc.TerminatorEnd && this.pos(Heredoc.TerminatorEnd),
+        }
+      : null,
   });
 
   private SglQuoted = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Dollar,
     Left,
     Right,
     Value,
   }: MvdanSh.SglQuoted): JSh.SglQuoted => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "SglQuoted",
     Dollar,
     Left: this.pos(Left),
@@ -686,6 +748,8 @@ export class ConvertMvdanShToJsh {
   private Stmt = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Background,
     Cmd,
     Comments,
@@ -695,7 +759,7 @@ export class ConvertMvdanShToJsh {
     Redirs,
     Semicolon,
   }: MvdanSh.Stmt): JSh.Stmt => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Stmt",
     Background,
     Cmd: Cmd ? this.Command(Cmd) : null,
@@ -707,16 +771,16 @@ export class ConvertMvdanShToJsh {
     Semicolon: Semicolon ? this.pos(Semicolon) : null,
   });
 
-  private Subshell = ({ Pos, End, Lparen, Rparen, Stmts }: MvdanSh.Subshell): JSh.Subshell => ({
-    ...this.base({ Pos, End }),
+  private Subshell = ({ Pos, End, ID, ParentID, Lparen, Rparen, Stmts }: MvdanSh.Subshell): JSh.Subshell => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Subshell",
     Lparen: this.pos(Lparen),
     Rparen: this.pos(Rparen),
     Stmts: Stmts.map(this.Stmt),
   });
 
-  private TestClause = ({ Pos, End, Left, Right, X }: MvdanSh.TestClause): JSh.TestClause => ({
-    ...this.base({ Pos, End }),
+  private TestClause = ({ Pos, End, ID, ParentID, Left, Right, X }: MvdanSh.TestClause): JSh.TestClause => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "TestClause",
     Left: this.pos(Left),
     Right: this.pos(Right),
@@ -737,11 +801,13 @@ export class ConvertMvdanShToJsh {
   private TimeClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     PosixFormat,
     Stmt,
     Time,
   }: MvdanSh.TimeClause): JSh.TimeClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "TimeClause",
     PosixFormat,
     Stmt: Stmt ? this.Stmt(Stmt) : null,
@@ -751,12 +817,14 @@ export class ConvertMvdanShToJsh {
   private UnaryArithm = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Op,
     OpPos,
     Post,
     X,
   }: MvdanSh.UnaryArithm): JSh.UnaryArithm => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "UnaryArithm",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -764,8 +832,8 @@ export class ConvertMvdanShToJsh {
     X: this.ArithmExpr(X),
   });
 
-  private UnaryTest = ({ Pos, End, Op, OpPos, X }: MvdanSh.UnaryTest): JSh.UnaryTest => ({
-    ...this.base({ Pos, End }),
+  private UnaryTest = ({ Pos, End, ID, ParentID, Op, OpPos, X }: MvdanSh.UnaryTest): JSh.UnaryTest => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "UnaryTest",
     Op: this.op(Op),
     OpPos: this.pos(OpPos),
@@ -775,6 +843,8 @@ export class ConvertMvdanShToJsh {
   private WhileClause = ({
     Pos,
     End,
+    ID,
+    ParentID,
     Cond,
     Do,
     DonePos,
@@ -782,7 +852,7 @@ export class ConvertMvdanShToJsh {
     Until,
     WhilePos,
   }: MvdanSh.WhileClause): JSh.WhileClause => ({
-    ...this.base({ Pos, End }),
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "WhileClause",
     Cond: Cond.map((Stmt) => this.Stmt(Stmt)),
     Do: Do.map((Stmt) => this.Stmt(Stmt)),
@@ -792,14 +862,14 @@ export class ConvertMvdanShToJsh {
     WhilePos: this.pos(WhilePos),
   });
 
-  private Word = ({ Pos, End, Parts }: MvdanSh.Word): JSh.Word => ({
-    ...this.base({ Pos, End }),
+  private Word = ({ Pos, End, ID, ParentID, Parts }: MvdanSh.Word): JSh.Word => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "Word",
     Parts: Parts.map(this.WordPart),
   });
 
-  private WordIter = ({ Pos, End, Items, Name }: MvdanSh.WordIter): JSh.WordIter => ({
-    ...this.base({ Pos, End }),
+  private WordIter = ({ Pos, End, ID, ParentID, Items, Name }: MvdanSh.WordIter): JSh.WordIter => ({
+    ...this.base({ Pos, End, ID, ParentID }),
     type: "WordIter",
     Items: Items.map(this.Word),
     Name: this.Lit(Name),
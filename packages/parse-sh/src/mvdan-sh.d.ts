@@ -22,6 +22,10 @@ export declare namespace MvdanSh {
      * next line. Comments are ignored, except if the node is a *File.
      */
     End: MvdanSh.Pos;
+    /** Pre-order index of the node within its file, where the file is 0 */
+    ID: number;
+    /** `ID` of the parent node, or -1 for the file */
+    ParentID: number;
   }
   // type Op = number;
   type Op = string;
@@ -657,6 +661,74 @@ export declare namespace MvdanSh {
      * Non-null iff here-document body
      */
     Hdoc: null | WordGeneric<Base, Pos, Op>;
+    /**
+     * What the redirect does to file descriptors.
+     */
+    Fd: RedirectFd;
+    /**
+     * Non-null iff `<<` or `<<-`.
+     */
+    Heredoc: null | Heredoc<Pos>;
+  };
+  type RedirectFd = {
+    Kind:
+      | "input"
+      | "output"
+      | "append"
+      | "clobber"
+      | "input-output"
+      | "dup-input"
+      | "dup-output"
+      | "close"
+      | "heredoc"
+      | "herestring";
+    /**
+     * Redirected fd, from `N` or the default of `Op`, or `-1` for `FdVar`.
+     */
+    Fd: number;
+    /**
+     * `{varname}>out` allocates an fd stored in `varname`.
+     */
+    FdVar: string;
+    /**
+     * Also redirect stderr, for `&>`, `&>>` and `>&file`.
+     */
+    Stderr: boolean;
+    /**
+     * Dup target e.g. `1` for `2>&1`, or `-1` if only known at runtime.
+     */
+    TargetFd: number;
+    /**
+     * Close `TargetFd` after dup e.g. `2>&1-`.
+     */
+    Move: boolean;
+  };
+  type Heredoc<Pos> = {
+    /**
+     * Unquoted e.g. `EOF` for `<<'EOF'`.
+     */
+    Delimiter: string;
+    /**
+     * Quoted delimiter means the body is not expanded.
+     */
+    Quoted: boolean;
+    /**
+     * `<<-` strips leading tabs.
+     */
+    StripTabs: boolean;
+    /**
+     * An empty body ranges from the start of the terminator line to itself, e.g. `BodyEnd`.
+     * Ranges are only null for a heredoc mapped without its text e.g. not by `parse`,
+     * since the parser gives an empty body no word.
+     */
+    BodyPos: null | Pos;
+    BodyEnd: null | Pos;
+    TerminatorPos: null | Pos;
+    TerminatorEnd: null | Pos;
+    /**
+     * Body without the terminator line, tabs stripped for `<<-`.
+     */
+    Body: string;
   };
   /**
    * Replace represents a search and replace expression inside a ParamExp.
@@ -1,11 +1,14 @@
 import { jsonParser } from "@npc-cli/util";
 import z from "zod";
+import type { MvdanSh } from "./mvdan-sh.d";
 
 export const ParseResultSchema = jsonParser.pipe(
   z.object({
     file: z.looseObject({
       Type: z.literal("File"),
       Name: z.string(),
+      ID: z.number(),
+      ParentID: z.number(),
       Stmts: z.array(z.unknown()), // Could extend
     }),
     text: z.string(),
@@ -17,10 +20,46 @@ export const ParseResultSchema = jsonParser.pipe(
         Pos: z.unknown().optional(),
       })
       .nullish(),
+    limitError: z
+      .object({
+        Kind: z.enum(["InputBytes", "Nodes", "Depth", "OutputBytes", "Steps", "Time"]),
+        Limit: z.number(),
+        Message: z.string(),
+      })
+      .nullish(),
     message: z.string(),
+    aliases: z.array(z.unknown()).nullish(),
+    variant: z.number().optional(),
+    variantSource: z.enum(["option", "directive", "shebang", "extension", "default"]).optional(),
+    nodes: z.array(z.custom<NodeInfo>()).nullish(),
   }),
 );
 
+/** A node of a parsed file by `ID`, so it can be referred to without object identity */
+export interface NodeInfo {
+  ID: number;
+  /** -1 for the file */
+  ParentID: number;
+  Type: string;
+  /** Null if the node lacks a range e.g. `Expansion` */
+  Pos: null | MvdanSh.Pos;
+  End: null | MvdanSh.Pos;
+}
+
+/** Text produced by expanding an alias, see `ExpandAliases` */
+export interface AliasExpansion {
+  Name: string;
+  Value: string;
+  /** Index of the expansion containing this one, else -1 */
+  Parent: number;
+  /** Range in the expanded text */
+  Pos: MvdanSh.Pos;
+  End: MvdanSh.Pos;
+  /** Range of the alias name in the original text, or of the outermost expansion if nested */
+  OrigPos: MvdanSh.Pos;
+  OrigEnd: MvdanSh.Pos;
+}
+
 export type LangVariant = (typeof LangVariant)[keyof typeof LangVariant];
 
 export const LangVariant = {
@@ -63,7 +102,9 @@ export const LangVariant = {
    * end-user applications like shfmt, which can guess a file's language variant
    * given its filename or shebang.
    *
-   * At this time, [Variant] does not support LangAuto.
+   * We detect it from a `# shell=…` directive among the leading comments, the shebang, or the extension of
+   * `filepath` (`.bash`, `.mksh` or `.bats`), defaulting to LangBash. The chosen
+   * variant is reported by `parse`.
    */
   LangAuto: 4,
 } as const;
@@ -115,6 +156,35 @@ export interface ShParserOptions {
    * recovered.
    */
   recoverErrors?: number;
+  /**
+   * Aliases expanded at command name positions, as bash would before parsing
+   * each command e.g. `{ ll: "ls -l" }`. Expansions are reported in the result.
+   */
+  aliases?: Record<string, string>;
+  /**
+   * Resource limits, where omitted or zero means unlimited. Exceeding one throws a
+   * `LimitError` e.g. for a pasted multi-megabyte line or deeply nested `$( $( … ) )`.
+   */
+  limits?: ParseLimits;
+  /**
+   * Also list the nodes indexed by `ID`, with their type and range e.g. for breakpoints,
+   * coverage or caches which cannot rely on object identity, say after `cloneParsed`.
+   */
+  nodeTable?: boolean;
+}
+
+export interface ParseLimits {
+  MaxInputBytes?: number;
+  /** Nodes of the syntax tree */
+  MaxNodes?: number;
+  /** Nesting of the syntax tree, also estimated from the text before parsing */
+  MaxDepth?: number;
+  /** Size of the JSON result */
+  MaxOutputBytes?: number;
+  /** Nodes mapped before giving up */
+  MaxSteps?: number;
+  /** Milliseconds spent mapping before giving up */
+  MaxMillis?: number;
 }
 
 export interface ShOptions extends ShParserOptions {
@@ -1,7 +1,13 @@
 // Based on https://github.com/un-ts/sh-syntax/blob/main/src/processor.ts
 import "./vendors/wasm_exec.js";
 import type { MvdanSh } from "./mvdan-sh.d";
-import { LangVariant, ParseResultSchema, type ShOptions } from "./mvdan-sh.model.js";
+import {
+  type AliasExpansion,
+  LangVariant,
+  type NodeInfo,
+  ParseResultSchema,
+  type ShOptions,
+} from "./mvdan-sh.model.js";
 
 export async function loadWasm() {
   const go = new Go();
@@ -53,11 +59,21 @@ export async function parse(
     variant = LangVariant.LangBash,
     stopAt = "",
     recoverErrors = 0,
+    aliases = {},
+    limits = {},
+    nodeTable = false,
   }: ShOptions = {},
 ): Promise<null | {
+  /** The expanded text if aliases were expanded */
   text: string;
   file: MvdanSh.File;
   message: string;
+  aliases: AliasExpansion[];
+  /** The variant parsed with, detected if `LangAuto` was passed */
+  variant: LangVariant;
+  variantSource: "option" | "directive" | "shebang" | "extension" | "default";
+  /** Indexed by node `ID`, if `nodeTable` was passed */
+  nodes: null | NodeInfo[];
 }> {
   const { go, wasm } = await loadWasm();
 
@@ -79,6 +95,8 @@ export async function parse(
   const filePath = encoder.encode(filepath);
   const textBuffer = encoder.encode(text);
   const uStopAt = encoder.encode(stopAt);
+  const aliasesBuffer = encoder.encode(JSON.stringify(aliases));
+  const limitsBuffer = encoder.encode(JSON.stringify(limits));
 
   const filePathPointer = wasmAlloc(filePath.byteLength);
   new Uint8Array(memory.buffer).set(filePath, filePathPointer);
@@ -86,6 +104,10 @@ export async function parse(
   new Uint8Array(memory.buffer).set(textBuffer, textPointer);
   const stopAtPointer = wasmAlloc(uStopAt.byteLength);
   new Uint8Array(memory.buffer).set(uStopAt, stopAtPointer);
+  const aliasesPointer = wasmAlloc(aliasesBuffer.byteLength);
+  new Uint8Array(memory.buffer).set(aliasesBuffer, aliasesPointer);
+  const limitsPointer = wasmAlloc(limitsBuffer.byteLength);
+  new Uint8Array(memory.buffer).set(limitsBuffer, limitsPointer);
 
   const resultPointer = (interactive === true ? transpiledInteractiveParse : transpiledParse)(
     filePathPointer,
@@ -102,11 +124,20 @@ export async function parse(
     uStopAt.byteLength,
     uStopAt.byteLength,
     recoverErrors,
+    aliasesPointer,
+    aliasesBuffer.byteLength,
+    aliasesBuffer.byteLength,
+    limitsPointer,
+    limitsBuffer.byteLength,
+    limitsBuffer.byteLength,
+    nodeTable,
   );
 
   wasmFree(filePathPointer);
   wasmFree(textPointer);
   wasmFree(stopAtPointer);
+  wasmFree(aliasesPointer);
+  wasmFree(limitsPointer);
 
   if (resultPointer === 0) {
     if (interactive === true) {
@@ -121,10 +152,23 @@ export async function parse(
   // console.log({ resultString });
 
   try {
-    const { file, message, text, parseError } = ParseResultSchema.parse(resultString);
+    const {
+      file,
+      message,
+      text,
+      parseError,
+      limitError,
+      aliases,
+      variant: usedVariant,
+      variantSource,
+      nodes,
+    } = ParseResultSchema.parse(resultString);
     if (parseError) {
       throw new ParseError(parseError);
     }
+    if (limitError) {
+      throw new LimitError(limitError);
+    }
 
     return {
       text,
@@ -132,11 +176,17 @@ export async function parse(
         type: "File",
         Name: file.Name,
         Stmts: file.Stmts as MvdanSh.Stmt[],
+        ID: file.ID,
+        ParentID: file.ParentID,
       },
       message,
+      aliases: (aliases ?? []) as AliasExpansion[],
+      variant: (usedVariant ?? variant) as LangVariant,
+      variantSource: variantSource ?? "option",
+      nodes: nodes ?? null,
     };
   } catch (e) {
-    if (e instanceof ParseError) {
+    if (e instanceof ParseError || e instanceof LimitError) {
       throw e;
     } else {
       console.error(e);
@@ -145,6 +195,22 @@ export async function parse(
   }
 }
 
+/**
+ * A parser handle with its own alias table, e.g. per session, so `alias ll='ls -l'`
+ * affects subsequent parses.
+ */
+export function createParser(options: ShOptions = {}) {
+  let aliases = { ...options.aliases };
+  return {
+    parse: (text: string, overrides: ShOptions = {}) =>
+      parse(text, { ...options, ...overrides, aliases: { ...aliases, ...overrides.aliases } }),
+    getAliases: () => ({ ...aliases }),
+    setAliases(table: Record<string, string>) {
+      aliases = { ...table };
+    },
+  };
+}
+
 type WasmInstanceExports = {
   memory: WebAssembly.Memory;
   wasmAlloc: (size: number) => number;
@@ -164,6 +230,13 @@ type WasmInstanceExports = {
     stopAt0: number,
     stopAt1: number,
     recoverErrors: number,
+    aliasesPointer: number,
+    aliases0: number,
+    aliases1: number,
+    limitsPointer: number,
+    limits0: number,
+    limits1: number,
+    nodeTable: boolean,
   ) => number;
   interactiveParse: (
     filePathPointer: number,
@@ -180,6 +253,13 @@ type WasmInstanceExports = {
     stopAt0: number,
     stopAt1: number,
     recoverErrors: number,
+    aliasesPointer: number,
+    aliases0: number,
+    aliases1: number,
+    limitsPointer: number,
+    limits0: number,
+    limits1: number,
+    nodeTable: boolean,
   ) => number;
 };
 
@@ -203,3 +283,15 @@ export class ParseError extends Error {
     this.Text = Text;
   }
 }
+
+/** An exceeded `ShParserOptions.limits`, rather than exhausting memory or stalling */
+export class LimitError extends Error {
+  Kind: "InputBytes" | "Nodes" | "Depth" | "OutputBytes" | "Steps" | "Time";
+  Limit: number;
+
+  constructor({ Kind, Limit, Message }: { Kind: LimitError["Kind"]; Limit: number; Message: string }) {
+    super(Message);
+    this.Kind = Kind;
+    this.Limit = Limit;
+  }
+}